@@ -115,11 +115,12 @@ func (ociterm *OciTerm) init() {
 					return
 				}
 
-				regs, err := ociterm.ociController.ListRegions()
+				regs, homeRegion, err := ociterm.ociController.ListSubscribedRegions()
 				if err != nil {
 					ociterm.guiController.LogError(err.Error(), true)
 					return
 				} else {
+					ociterm.guiController.GetGUITopPanel().SetHomeRegion(homeRegion)
 					ociterm.guiController.GetGUITopPanel().UpdateRegions(&regs)
 				}
 
@@ -198,12 +199,89 @@ func (ociterm *OciTerm) init() {
 			} else {
 				ociterm.guiController.LogError("compartment has to be selected", true)
 			}
+		case "resources":
+			if ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId() != "" {
+				ociterm.currentPanel = gui.NewResourcesAsGUIPanel(conf.TenancyId, ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId(), ociterm.ociController, ociterm.guiController)
+				(*ociterm.currentPanel).Show(ociterm.mainPages)
+				ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+			} else {
+				ociterm.guiController.LogError("compartment has to be selected", true)
+			}
+		case "users":
+			// users are tenancy wide, compartment is not required
+			ociterm.currentPanel = gui.NewUsersAsGUIPanel(conf.TenancyId, conf.CompartmentId, ociterm.ociController, ociterm.guiController)
+			(*ociterm.currentPanel).Show(ociterm.mainPages)
+			ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+		case "groups":
+			ociterm.currentPanel = gui.NewGroupsAsGUIPanel(conf.TenancyId, conf.CompartmentId, ociterm.ociController, ociterm.guiController)
+			(*ociterm.currentPanel).Show(ociterm.mainPages)
+			ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+		case "policies":
+			ociterm.currentPanel = gui.NewPoliciesAsGUIPanel(conf.TenancyId, conf.CompartmentId, ociterm.ociController, ociterm.guiController)
+			(*ociterm.currentPanel).Show(ociterm.mainPages)
+			ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+		case "mycredentials":
+			ociterm.currentPanel = gui.NewMyCredentialsAsGUIPanel(conf.TenancyId, conf.CompartmentId, ociterm.ociController, ociterm.guiController)
+			(*ociterm.currentPanel).Show(ociterm.mainPages)
+			ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+		case "regions":
+			ociterm.currentPanel = gui.NewRegionsAsGUIPanel(conf.TenancyId, conf.CompartmentId, ociterm.ociController, ociterm.guiController)
+			(*ociterm.currentPanel).Show(ociterm.mainPages)
+			ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+		case "topology":
+			if ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId() != "" {
+				ociterm.currentPanel = gui.NewTopologyAsGUIPanel(conf.TenancyId, ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId(), ociterm.ociController, ociterm.guiController)
+				(*ociterm.currentPanel).Show(ociterm.mainPages)
+				ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+			} else {
+				ociterm.guiController.LogError("compartment has to be selected", true)
+			}
+		case "alarms":
+			if ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId() != "" {
+				ociterm.currentPanel = gui.NewAlarmsAsGUIPanel(conf.TenancyId, ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId(), ociterm.ociController, ociterm.guiController)
+				(*ociterm.currentPanel).Show(ociterm.mainPages)
+				ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+			} else {
+				ociterm.guiController.LogError("compartment has to be selected", true)
+			}
+		case "metrics":
+			if ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId() != "" {
+				ociterm.currentPanel = gui.NewMetricsExplorerAsGUIPanel(conf.TenancyId, ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId(), ociterm.ociController, ociterm.guiController)
+				(*ociterm.currentPanel).Show(ociterm.mainPages)
+				ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+			} else {
+				ociterm.guiController.LogError("compartment has to be selected", true)
+			}
+		case "workrequests":
+			ociterm.currentPanel = gui.NewWorkRequestsAsGUIPanel(conf.TenancyId, ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId(), ociterm.ociController, ociterm.guiController)
+			(*ociterm.currentPanel).Show(ociterm.mainPages)
+			ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+		case "domains":
+			if ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId() != "" {
+				ociterm.currentPanel = gui.NewDomainsAsGUIPanel(conf.TenancyId, ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId(), ociterm.ociController, ociterm.guiController)
+				(*ociterm.currentPanel).Show(ociterm.mainPages)
+				ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+			} else {
+				ociterm.guiController.LogError("compartment has to be selected", true)
+			}
+		case "tags":
+			if ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId() != "" {
+				ociterm.currentPanel = gui.NewTagsAsGUIPanel(conf.TenancyId, ociterm.guiController.GetGUITopPanel().GetSelectedCompartmentId(), ociterm.ociController, ociterm.guiController)
+				(*ociterm.currentPanel).Show(ociterm.mainPages)
+				ociterm.errorTextArea.SetText((*ociterm.currentPanel).GetInfo())
+			} else {
+				ociterm.guiController.LogError("compartment has to be selected", true)
+			}
 		}
 	})
 }
 
 func (ociterm *OciTerm) Run() {
-	if err := ociterm.app.SetRoot(ociterm.guiController.GetGUIPages(), true).EnableMouse(true).Run(); err != nil {
+	screen, err := gui.NewScreen()
+	if err != nil {
+		panic(err)
+	}
+	if err := ociterm.app.SetScreen(screen).SetRoot(ociterm.guiController.GetGUIPages(), true).EnableMouse(true).Run(); err != nil {
 		panic(err)
 	}
 	defer ociterm.ociController.CloseContext()
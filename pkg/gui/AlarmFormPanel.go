@@ -0,0 +1,297 @@
+package gui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/jszczuko/plot4tview/pkg/gui"
+	"github.com/oracle/oci-go-sdk/v52/monitoring"
+	"github.com/rivo/tview"
+)
+
+var alarmSeverities = []string{
+	string(monitoring.AlarmSeverityCritical),
+	string(monitoring.AlarmSeverityError),
+	string(monitoring.AlarmSeverityWarning),
+	string(monitoring.AlarmSeverityInfo),
+}
+
+var alarmPendingDurations = []string{"PT1M", "PT5M", "PT10M", "PT15M", "PT30M", "PT1H"}
+
+// Panel creating alarm or updating existing one.
+// Query can be previewed on data of last 24 hours before alarm is saved.
+type AlarmFormPanel struct {
+	guiController     *GuiController
+	ociController     *oci.OCIController
+	alarm             *monitoring.Alarm
+	compartmentId     string
+	grid              *tview.Grid
+	form              *tview.Form
+	preview           *gui.BarPlot
+	nameInput         *tview.InputField
+	namespaceInput    *tview.InputField
+	queryInput        *tview.InputField
+	triggerInput      *tview.InputField
+	severitySelect    *tview.DropDown
+	pendingSelect     *tview.DropDown
+	destinationsInput *tview.InputField
+	bodyInput         *tview.InputField
+	enabledCheck      *tview.Checkbox
+	closeFunc         func(changed bool)
+}
+
+// Creates panel for new alarm in CompartmentId if Alarm is nil, otherwise for editing Alarm.
+func NewAlarmFormPanel(GuiController *GuiController, OciController *oci.OCIController, CompartmentId string, Alarm *monitoring.Alarm) *AlarmFormPanel {
+	res := AlarmFormPanel{
+		guiController:     GuiController,
+		ociController:     OciController,
+		alarm:             Alarm,
+		compartmentId:     CompartmentId,
+		grid:              tview.NewGrid(),
+		form:              tview.NewForm(),
+		nameInput:         tview.NewInputField().SetLabel("Name:").SetFieldWidth(50),
+		namespaceInput:    tview.NewInputField().SetLabel("Namespace:").SetFieldWidth(50).SetText("oci_computeagent"),
+		queryInput:        tview.NewInputField().SetLabel("Query:").SetFieldWidth(70).SetPlaceholder("CpuUtilization[1m].mean()"),
+		triggerInput:      tview.NewInputField().SetLabel("Trigger:").SetFieldWidth(30).SetPlaceholder("> 80"),
+		severitySelect:    tview.NewDropDown().SetLabel("Severity:").SetOptions(alarmSeverities, nil).SetCurrentOption(0),
+		pendingSelect:     tview.NewDropDown().SetLabel("Pending duration:").SetOptions(alarmPendingDurations, nil).SetCurrentOption(0),
+		destinationsInput: tview.NewInputField().SetLabel("Topic OCIDs:").SetFieldWidth(70),
+		bodyInput:         tview.NewInputField().SetLabel("Body:").SetFieldWidth(70),
+		enabledCheck:      tview.NewCheckbox().SetLabel("Enabled:").SetChecked(true),
+		closeFunc:         func(changed bool) {},
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *AlarmFormPanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *AlarmFormPanel) GetPanelName() string {
+	return "AlarmFormPanel"
+}
+
+func (panel *AlarmFormPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+// Function called when panel is closed, changed is true if alarm was created or updated.
+func (panel *AlarmFormPanel) SetCloseFunc(close func(changed bool)) {
+	panel.closeFunc = close
+}
+
+func (panel *AlarmFormPanel) createGUI() {
+	title := "New Alarm"
+	if panel.alarm != nil {
+		title = "Edit Alarm"
+		query, trigger := oci.SplitAlarmQuery(oci.StringOrEmpty(panel.alarm.Query))
+		panel.nameInput.SetText(oci.StringOrEmpty(panel.alarm.DisplayName))
+		panel.namespaceInput.SetText(oci.StringOrEmpty(panel.alarm.Namespace))
+		panel.queryInput.SetText(query)
+		panel.triggerInput.SetText(trigger)
+		if idx := indexOf(alarmSeverities, string(panel.alarm.Severity)); idx >= 0 {
+			panel.severitySelect.SetCurrentOption(idx)
+		}
+		if panel.alarm.PendingDuration != nil {
+			idx := indexOf(alarmPendingDurations, *panel.alarm.PendingDuration)
+			if idx < 0 {
+				durations := append(alarmPendingDurations, *panel.alarm.PendingDuration)
+				panel.pendingSelect.SetOptions(durations, nil)
+				idx = len(durations) - 1
+			}
+			panel.pendingSelect.SetCurrentOption(idx)
+		}
+		panel.destinationsInput.SetText(strings.Join(panel.alarm.Destinations, ", "))
+		panel.bodyInput.SetText(oci.StringOrEmpty(panel.alarm.Body))
+		panel.enabledCheck.SetChecked(panel.alarm.IsEnabled == nil || *panel.alarm.IsEnabled)
+	}
+	panel.form.AddFormItem(panel.nameInput).
+		AddFormItem(panel.namespaceInput).
+		AddFormItem(panel.queryInput).
+		AddFormItem(panel.triggerInput).
+		AddFormItem(panel.severitySelect).
+		AddFormItem(panel.pendingSelect).
+		AddFormItem(panel.destinationsInput).
+		AddFormItem(panel.bodyInput).
+		AddFormItem(panel.enabledCheck).
+		AddButton("Preview", panel.loadPreview).
+		AddButton("Save", panel.save).
+		AddButton("Cancel", func() { panel.closeFunc(false) })
+	panel.form.SetCancelFunc(func() { panel.closeFunc(false) })
+	panel.form.SetBorder(true).SetTitle(title)
+
+	panel.preview = panel.newPreviewPlot()
+
+	panel.grid.SetColumns(0, 92, 80, 0)
+	panel.grid.SetRows(0, 23, 0)
+	panel.grid.AddItem(panel.form, 1, 1, 1, 1, 0, 0, true)
+	panel.grid.AddItem(panel.preview, 1, 2, 1, 1, 0, 0, false)
+}
+
+// Creates empty preview plot, plot cannot be cleared so new one replaces it on every preview.
+func (panel *AlarmFormPanel) newPreviewPlot() *gui.BarPlot {
+	plot := gui.NewBarPlot()
+	plot.SetBorder(true).SetTitle(" Preview of last 24 h ")
+	plot.SetXAxisText("Time", 0)
+	plot.SetYAxisText("Value", 1)
+	plot.SetNoDataText("Select Preview")
+	plot.SetAxis2String(func(value float64) string {
+		return time.Unix(int64(value), 0).Format("15:04")
+	}, func(value float64) string {
+		return fmt.Sprintf("%.2f", value)
+	})
+	operator, threshold, ok := parseAlarmTrigger(panel.triggerInput.GetText())
+	plot.SetStyleForPointFunc(func(point []float64) tcell.Style {
+		if ok && alarmTriggerMatches(operator, threshold, point[1]) {
+			return tcell.StyleDefault.Background(tcell.ColorRed)
+		}
+		return tcell.StyleDefault.Background(tcell.ColorGreen)
+	})
+	return plot
+}
+
+// Runs metric query (without trigger) over last 24 hours and plots the first returned stream.
+// Points matching trigger rule are highlighted.
+func (panel *AlarmFormPanel) loadPreview() {
+	namespace := strings.TrimSpace(panel.namespaceInput.GetText())
+	query := strings.TrimSpace(panel.queryInput.GetText())
+	if namespace == "" || query == "" {
+		panel.guiController.LogErrorOnPage("namespace and query are required for preview", panel.GetPanelName(), panel.form)
+		return
+	}
+	metricCompartmentId := panel.compartmentId
+	if panel.alarm != nil && panel.alarm.MetricCompartmentId != nil {
+		metricCompartmentId = *panel.alarm.MetricCompartmentId
+	}
+	plot := panel.newPreviewPlot()
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		series, err := panel.ociController.PreviewAlarmQuery(metricCompartmentId, namespace, query)
+		if err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+			return
+		}
+		if len(series) == 0 || len(series[0].Points) < 2 {
+			plot.SetNoDataText("No data returned")
+		} else {
+			plot.SetData(series[0].Points)
+			title := " " + tview.Escape(series[0].Label()) + " "
+			if len(series) > 1 {
+				title = fmt.Sprintf(" %s (1 of %d streams) ", tview.Escape(series[0].Label()), len(series))
+			}
+			plot.SetTitle(title)
+		}
+		panel.guiController.QueueUpdateDraw(func() {
+			panel.grid.RemoveItem(panel.preview)
+			panel.preview = plot
+			panel.grid.AddItem(panel.preview, 1, 2, 1, 1, 0, 0, false)
+		})
+	}()
+}
+
+func (panel *AlarmFormPanel) save() {
+	name := strings.TrimSpace(panel.nameInput.GetText())
+	namespace := strings.TrimSpace(panel.namespaceInput.GetText())
+	query := strings.TrimSpace(panel.queryInput.GetText())
+	trigger := strings.TrimSpace(panel.triggerInput.GetText())
+	if name == "" || namespace == "" || query == "" || trigger == "" {
+		panel.guiController.LogErrorOnPage("name, namespace, query and trigger are required", panel.GetPanelName(), panel.form)
+		return
+	}
+	if _, _, ok := parseAlarmTrigger(trigger); !ok {
+		panel.guiController.LogErrorOnPage("trigger has to be operator followed by value, e.g. > 80", panel.GetPanelName(), panel.form)
+		return
+	}
+	var destinations []string
+	for _, destination := range strings.Split(panel.destinationsInput.GetText(), ",") {
+		if destination = strings.TrimSpace(destination); destination != "" {
+			destinations = append(destinations, destination)
+		}
+	}
+	if len(destinations) == 0 {
+		panel.guiController.LogErrorOnPage("at least one notification topic OCID is required", panel.GetPanelName(), panel.form)
+		return
+	}
+	_, severity := panel.severitySelect.GetCurrentOption()
+	_, pending := panel.pendingSelect.GetCurrentOption()
+	definition := oci.AlarmDefinition{
+		DisplayName:     name,
+		Namespace:       namespace,
+		Query:           oci.JoinAlarmQuery(query, trigger),
+		Severity:        severity,
+		PendingDuration: pending,
+		Destinations:    destinations,
+		Body:            strings.TrimSpace(panel.bodyInput.GetText()),
+		IsEnabled:       panel.enabledCheck.IsChecked(),
+	}
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		var err error
+		if panel.alarm == nil {
+			_, err = panel.ociController.CreateAlarm(panel.compartmentId, definition)
+		} else {
+			_, err = panel.ociController.UpdateAlarm(*panel.alarm.Id, definition)
+		}
+		if err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+			return
+		}
+		panel.closeFunc(true)
+	}()
+}
+
+// Parses simple trigger rule like "> 80" to operator and threshold.
+// Range rules (in, not in) are not parsed, ok is false for them.
+func parseAlarmTrigger(trigger string) (operator string, threshold float64, ok bool) {
+	trigger = strings.TrimSpace(trigger)
+	for _, op := range []string{">=", "<=", "==", "!=", ">", "<"} {
+		if strings.HasPrefix(trigger, op) {
+			value, err := strconv.ParseFloat(strings.TrimSpace(trigger[len(op):]), 64)
+			if err != nil {
+				return "", 0, false
+			}
+			return op, value, true
+		}
+	}
+	return "", 0, strings.HasPrefix(trigger, "in") || strings.HasPrefix(trigger, "not in")
+}
+
+func alarmTriggerMatches(operator string, threshold float64, value float64) bool {
+	switch operator {
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	case "==":
+		return value == threshold
+	case "!=":
+		return value != threshold
+	}
+	return false
+}
+
+func indexOf(values []string, value string) int {
+	for idx, v := range values {
+		if v == value {
+			return idx
+		}
+	}
+	return -1
+}
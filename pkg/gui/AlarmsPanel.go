@@ -0,0 +1,400 @@
+package gui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/monitoring"
+	"github.com/rivo/tview"
+)
+
+const alarmHistoryDays = 90
+
+type alarmsGUI struct {
+	mainGrid      *tview.Grid
+	refreshButton *tview.Button
+	mainTable     *tview.Table
+}
+
+// Panel listing alarms of the compartment with their current status.
+// Shows history of alarm state transitions and suppresses alarm notifications.
+type AlarmsPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *alarmsGUI
+	alarms        []oci.AlarmOverview
+	tenancyId     string
+	compartmentId string
+}
+
+func NewAlarmsPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *AlarmsPanel {
+	res := AlarmsPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newAlarmsGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewAlarmsAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewAlarmsPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newAlarmsGUI() *alarmsGUI {
+	res := alarmsGUI{
+		mainGrid:      tview.NewGrid(),
+		refreshButton: tview.NewButton("Refresh"),
+		mainTable:     tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *AlarmsPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 30)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 1, 1, 1, 0, 0, false)
+
+	panel.gui.mainTable.SetBorder(true).SetTitle("Alarms Table")
+	panel.gui.mainGrid.AddItem(panel.gui.mainTable, 2, 0, 1, 3, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *AlarmsPanel) makeKeyBindings() {
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.mainTable, panel.gui.mainTable, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.mainTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		}
+	})
+	panel.gui.mainTable.SetSelectedFunc(func(row, column int) {
+		if alarm := panel.getSelectedAlarm(); alarm != nil {
+			panel.showHistory(*alarm)
+		}
+	})
+	panel.gui.mainTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if tcell.KeyRune != event.Key() {
+			return event
+		}
+		switch event.Rune() {
+		case 'n':
+			panel.showFormPanel(nil)
+			return nil
+		case 'e':
+			if alarm := panel.getSelectedAlarm(); alarm != nil {
+				panel.editAlarm(*alarm.Alarm.Id)
+			}
+			return nil
+		case 's':
+			if alarm := panel.getSelectedAlarm(); alarm != nil {
+				panel.showSuppressForm(*alarm)
+			}
+			return nil
+		case 'u':
+			if alarm := panel.getSelectedAlarm(); alarm != nil {
+				panel.showRemoveSuppressionModal(*alarm)
+			}
+			return nil
+		}
+		return event
+	})
+}
+
+func (panel *AlarmsPanel) getSelectedAlarm() *oci.AlarmOverview {
+	row, _ := panel.gui.mainTable.GetSelection()
+	if row < 1 || row > len(panel.alarms) {
+		return nil
+	}
+	return &panel.alarms[row-1]
+}
+
+// Reloads alarms and their status from OCI.
+func (panel *AlarmsPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
+		}()
+		alarms, err := panel.ociController.ListAlarmsOverview(panel.compartmentId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.alarms = alarms
+		panel.refreshTable()
+	}()
+}
+
+func (panel *AlarmsPanel) refreshTable() {
+	table := panel.gui.mainTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"NAME", "SEVERITY", "STATUS", "TRIGGERED", "SUPPRESSED UNTIL", "QUERY", "DESTINATIONS"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range panel.alarms {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		triggered := ""
+		if !val.TimestampTriggered.IsZero() && val.Status == monitoring.AlarmStatusSummaryStatusFiring {
+			triggered = val.TimestampTriggered.Local().Format(time.RFC822)
+		}
+		suppressed := ""
+		if val.Alarm.Suppression != nil && val.Alarm.Suppression.TimeSuppressUntil != nil {
+			suppressed = val.Alarm.Suppression.TimeSuppressUntil.Local().Format(time.RFC822)
+		}
+		severityS, severityC := panel.severityToString(val.Alarm.Severity)
+		statusS, statusC := panel.statusToString(val)
+		table.SetCell(row, 0, tview.NewTableCell(tview.Escape(*val.Alarm.DisplayName)).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(severityS).SetAlign(tview.AlignCenter).SetTextColor(severityC))
+		table.SetCell(row, 2, tview.NewTableCell(statusS).SetAlign(tview.AlignCenter).SetTextColor(statusC))
+		table.SetCell(row, 3, tview.NewTableCell(triggered).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 4, tview.NewTableCell(suppressed).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 5, tview.NewTableCell(tview.Escape(*val.Alarm.Query)).SetAlign(tview.AlignLeft).SetTextColor(cellcolor).SetMaxWidth(60))
+		table.SetCell(row, 6, tview.NewTableCell(strings.Join(val.Alarm.Destinations, ", ")).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+	}
+}
+
+func (panel *AlarmsPanel) severityToString(severity monitoring.AlarmSummarySeverityEnum) (string, tcell.Color) {
+	switch severity {
+	case monitoring.AlarmSummarySeverityCritical:
+		return string(severity), tcell.ColorRed
+	case monitoring.AlarmSummarySeverityError:
+		return string(severity), tcell.ColorOrange
+	case monitoring.AlarmSummarySeverityWarning:
+		return string(severity), tcell.ColorYellow
+	case monitoring.AlarmSummarySeverityInfo:
+		return string(severity), tcell.ColorLightBlue
+	default:
+		return string(severity), tcell.ColorWhite
+	}
+}
+
+func (panel *AlarmsPanel) statusToString(alarm oci.AlarmOverview) (string, tcell.Color) {
+	if alarm.Alarm.IsEnabled != nil && !*alarm.Alarm.IsEnabled {
+		return "DISABLED", tcell.ColorGray
+	}
+	if color, ok := alarmStatusColor(alarm.Status); ok {
+		return string(alarm.Status), color
+	}
+	return "UNKNOWN", tcell.ColorYellow
+}
+
+func alarmStatusColor(status monitoring.AlarmStatusSummaryStatusEnum) (tcell.Color, bool) {
+	switch status {
+	case monitoring.AlarmStatusSummaryStatusFiring:
+		return tcell.ColorRed, true
+	case monitoring.AlarmStatusSummaryStatusOk:
+		return tcell.ColorGreen, true
+	case monitoring.AlarmStatusSummaryStatusSuspended:
+		return tcell.ColorGray, true
+	default:
+		return tcell.ColorWhite, false
+	}
+}
+
+// Colors history entry by alarm status it transitioned to.
+// Summary is matched word by word (e.g. "State transitioned from OK to Firing"), last status wins.
+func alarmHistoryColor(summary string) tcell.Color {
+	color := tcell.ColorWhite
+	words := strings.FieldsFunc(strings.ToUpper(summary), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, word := range words {
+		if statusColor, ok := alarmStatusColor(monitoring.AlarmStatusSummaryStatusEnum(word)); ok {
+			color = statusColor
+		}
+	}
+	return color
+}
+
+// Shows state transitions of the alarm.
+func (panel *AlarmsPanel) showHistory(alarm oci.AlarmOverview) {
+	pageName := "AlarmHistory"
+	table := tview.NewTable().SetBorders(false).SetSelectable(true, false)
+	table.SetBorder(true).SetTitle(fmt.Sprintf("History of %s from last %d days (Esc: close)", tview.Escape(*alarm.Alarm.DisplayName), alarmHistoryDays))
+	table.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.RemovePage(pageName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+		}
+	})
+	grid := tview.NewGrid().SetColumns(0, 140, 0).SetRows(0, 30, 0)
+	grid.AddItem(table, 1, 1, 1, 1, 0, 0, true)
+
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		entries, err := panel.ociController.GetAlarmHistory(*alarm.Alarm.Id, alarmHistoryDays)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		for col, header := range []string{"TIMESTAMP", "TRIGGERED", "SUMMARY"} {
+			table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+		}
+		for row, entry := range entries {
+			row += 1
+			triggered := ""
+			if entry.TimestampTriggered != nil {
+				triggered = entry.TimestampTriggered.Local().Format(time.RFC822)
+			}
+			summaryColor := alarmHistoryColor(*entry.Summary)
+			table.SetCell(row, 0, tview.NewTableCell(entry.Timestamp.Local().Format(time.RFC822)).SetAlign(tview.AlignCenter))
+			table.SetCell(row, 1, tview.NewTableCell(triggered).SetAlign(tview.AlignCenter))
+			table.SetCell(row, 2, tview.NewTableCell(tview.Escape(*entry.Summary)).SetAlign(tview.AlignLeft).SetTextColor(summaryColor))
+		}
+		panel.guiController.AddPage(pageName, grid, true)
+		panel.guiController.SetFocus(table)
+	}()
+}
+
+// Loads full alarm definition and opens it in alarm form.
+func (panel *AlarmsPanel) editAlarm(alarmId string) {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		alarm, err := panel.ociController.GetAlarm(alarmId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.showFormPanel(alarm)
+	}()
+}
+
+func (panel *AlarmsPanel) showFormPanel(alarm *monitoring.Alarm) {
+	formPanel := NewAlarmFormPanel(panel.guiController, panel.ociController, panel.compartmentId, alarm)
+	formPanel.SetCloseFunc(func(changed bool) {
+		panel.guiController.RemovePage(formPanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if changed {
+			panel.reload()
+		}
+	})
+	panel.guiController.AddPage(formPanel.GetPanelName(), formPanel.GetGUI(), true)
+	panel.guiController.SetFocus(formPanel.GetFocusPrimitive())
+}
+
+// Shows form suppressing alarm notifications for given number of hours.
+func (panel *AlarmsPanel) showSuppressForm(alarm oci.AlarmOverview) {
+	formName := "AlarmSuppressForm"
+	form := tview.NewForm()
+	hoursInput := tview.NewInputField().SetLabel("Hours:").SetFieldWidth(10).
+		SetAcceptanceFunc(tview.InputFieldInteger).SetText("1")
+	descInput := tview.NewInputField().SetLabel("Description:").SetFieldWidth(60)
+	closeForm := func() {
+		panel.guiController.RemovePage(formName, n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+	}
+	save := func() {
+		hours, err := strconv.Atoi(hoursInput.GetText())
+		if err != nil || hours < 1 {
+			panel.guiController.LogErrorOnPage("number of hours has to be positive", formName, form)
+			return
+		}
+		description := strings.TrimSpace(descInput.GetText())
+		panel.guiController.SetLoading()
+		go func() {
+			defer func() {
+				panel.guiController.RemoveLoading()
+				panel.guiController.RefreshGUI()
+			}()
+			if err := panel.ociController.SuppressAlarm(*alarm.Alarm.Id, time.Duration(hours)*time.Hour, description); err != nil {
+				panel.guiController.LogErrorOnPage(err.Error(), formName, form)
+				return
+			}
+			closeForm()
+			panel.reload()
+		}()
+	}
+	form.AddFormItem(hoursInput).
+		AddFormItem(descInput).
+		AddButton("Suppress", save).
+		AddButton("Cancel", closeForm)
+	form.SetCancelFunc(closeForm)
+	form.SetBorder(true).SetTitle("Suppress " + tview.Escape(*alarm.Alarm.DisplayName))
+	grid := tview.NewGrid().SetColumns(0, 80, 0).SetRows(0, 9, 0)
+	grid.AddItem(form, 1, 1, 1, 1, 0, 0, true)
+	panel.guiController.AddPage(formName, grid, true)
+	panel.guiController.SetFocus(form)
+}
+
+func (panel *AlarmsPanel) showRemoveSuppressionModal(alarm oci.AlarmOverview) {
+	if alarm.Alarm.Suppression == nil {
+		panel.guiController.LogError(fmt.Sprintf("alarm %s is not suppressed", *alarm.Alarm.DisplayName), true)
+		return
+	}
+	modalName := "ModalAlarmSuppression"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to remove suppression of alarm %s?", *alarm.Alarm.DisplayName)).
+		AddButtons([]string{"Remove", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			if buttonLabel != "Remove" {
+				return
+			}
+			panel.guiController.SetLoading()
+			go func() {
+				defer func() {
+					panel.guiController.RemoveLoading()
+					panel.guiController.RefreshGUI()
+				}()
+				if err := panel.ociController.RemoveAlarmSuppression(*alarm.Alarm.Id); err != nil {
+					panel.guiController.LogError(err.Error(), true)
+					return
+				}
+				panel.reload()
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
+
+func (panel *AlarmsPanel) GetPanelName() string {
+	return "alarms"
+}
+
+func (panel *AlarmsPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *AlarmsPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *AlarmsPanel) GetInfo() string {
+	return "[red]Enter:[white] History [red]Esc:[white] Exit [green]n:[white] New [green]e:[white] Edit [green]s:[white] Suppress [green]u:[white] Remove suppression"
+}
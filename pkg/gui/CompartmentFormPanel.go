@@ -0,0 +1,125 @@
+package gui
+
+import (
+	"strings"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+// Panel creating compartment or renaming and changing description of existing one.
+type CompartmentFormPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	compartment   *identity.Compartment
+	parentIds     []string
+	grid          *tview.Grid
+	form          *tview.Form
+	parentSelect  *tview.DropDown
+	nameInput     *tview.InputField
+	descInput     *tview.InputField
+	closeFunc     func(changed bool)
+}
+
+// Creates panel for new compartment under ParentId if Compartment is nil, otherwise for editing Compartment.
+func NewCompartmentFormPanel(GuiController *GuiController, OciController *oci.OCIController, TenancyId string, ParentId string, Compartment *identity.Compartment) *CompartmentFormPanel {
+	res := CompartmentFormPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		compartment:   Compartment,
+		grid:          tview.NewGrid(),
+		form:          tview.NewForm(),
+		parentSelect:  tview.NewDropDown().SetLabel("Parent:"),
+		nameInput:     tview.NewInputField().SetLabel("Name:").SetFieldWidth(50),
+		descInput:     tview.NewInputField().SetLabel("Description:").SetFieldWidth(70),
+		closeFunc:     func(changed bool) {},
+	}
+	res.createGUI(TenancyId, ParentId)
+	return &res
+}
+
+func (panel *CompartmentFormPanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *CompartmentFormPanel) GetPanelName() string {
+	return "CompartmentFormPanel"
+}
+
+func (panel *CompartmentFormPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+// Function called when panel is closed, changed is true if compartment was created or updated.
+func (panel *CompartmentFormPanel) SetCloseFunc(close func(changed bool)) {
+	panel.closeFunc = close
+}
+
+func (panel *CompartmentFormPanel) createGUI(tenancyId string, parentId string) {
+	title := "Edit Compartment"
+	if panel.compartment == nil {
+		title = "New Compartment"
+		txt := []string{"root"}
+		panel.parentIds = []string{tenancyId}
+		selIdx := 0
+		for _, comp := range panel.guiController.GetGUITopPanel().GetCompartments() {
+			txt = append(txt, *comp.Name)
+			panel.parentIds = append(panel.parentIds, *comp.Id)
+			if *comp.Id == parentId {
+				selIdx = len(txt) - 1
+			}
+		}
+		panel.parentSelect.SetOptions(txt, nil)
+		panel.parentSelect.SetCurrentOption(selIdx)
+		panel.form.AddFormItem(panel.parentSelect)
+	} else {
+		panel.nameInput.SetText(oci.StringOrEmpty(panel.compartment.Name))
+		panel.descInput.SetText(oci.StringOrEmpty(panel.compartment.Description))
+	}
+	panel.form.AddFormItem(panel.nameInput).
+		AddFormItem(panel.descInput).
+		AddButton("Save", panel.save).
+		AddButton("Cancel", func() { panel.closeFunc(false) })
+	panel.form.SetCancelFunc(func() { panel.closeFunc(false) })
+	panel.form.SetBorder(true).SetTitle(title)
+
+	panel.grid.SetColumns(0, 100, 0)
+	panel.grid.SetRows(0, 11, 0)
+	panel.grid.AddItem(panel.form, 1, 1, 1, 1, 0, 0, true)
+}
+
+func (panel *CompartmentFormPanel) save() {
+	name := strings.TrimSpace(panel.nameInput.GetText())
+	description := strings.TrimSpace(panel.descInput.GetText())
+	if name == "" || description == "" {
+		panel.guiController.LogErrorOnPage("name and description are required", panel.GetPanelName(), panel.form)
+		return
+	}
+	parentId := ""
+	if panel.compartment == nil {
+		idx, _ := panel.parentSelect.GetCurrentOption()
+		if idx < 0 || idx >= len(panel.parentIds) {
+			return
+		}
+		parentId = panel.parentIds[idx]
+	}
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		var err error
+		if panel.compartment == nil {
+			_, err = panel.ociController.CreateCompartment(parentId, name, description)
+		} else {
+			_, err = panel.ociController.UpdateCompartment(*panel.compartment.Id, name, description)
+		}
+		if err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+			return
+		}
+		panel.closeFunc(true)
+	}()
+}
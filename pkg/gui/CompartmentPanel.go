@@ -1,6 +1,7 @@
 package gui
 
 import (
+	"fmt"
 	"log"
 	"sort"
 	"strconv"
@@ -258,46 +259,85 @@ func (panel *CompartmentPanel) makeKeyBindings() {
 		}()
 
 	})
-	panel.gui.refreshButton.SetSelectedFunc(func() {
-		panel.guiController.SetLoading()
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
 
-		go func() {
-			defer func() {
-				panel.guiController.RemoveLoading()
-				panel.guiController.SetFocus(panel.gui.mainTable)
-				panel.guiController.RefreshGUI()
-			}()
-			// if data already exists refresh
-			if panel.currentPageIdx > -1 {
-				panel.currentPageIdx = -1
-				panel.compartmentsPages = make([]compartmentsPage, 0)
-				panel.gui.mainTable.Clear()
+	panel.gui.mainTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if tcell.KeyRune != event.Key() {
+			return event
+		}
+		switch event.Rune() {
+		// n for new compartment
+		case 'n':
+			panel.showFormPanel(nil)
+			return nil
+		// e for editing name and description
+		case 'e':
+			if comp := panel.getSelectedCompartment(); comp != nil {
+				panel.showFormPanel(comp)
 			}
-			compartments, nextPage, err := panel.ociController.ListCompartments(
-				panel.compartmentId,
-				panel.getCurrnetLimit(),
-				panel.getCurrentAccessLevel(),
-				panel.getCurrentSortBy(),
-				panel.getCurrentSortOrder(),
-				panel.getCurrentLifecycleState(),
-				"",
-			)
-			if err != nil {
-				log.Fatal(err.Error())
-				return
+			return nil
+		// v for moving compartment
+		case 'v':
+			if comp := panel.getSelectedCompartment(); comp != nil {
+				panel.showMovePanel(comp)
 			}
-			p := ""
-			panel.compartmentsPages = append(panel.compartmentsPages, compartmentsPage{
-				page:         &p,
-				compartments: &compartments,
-				nextPage:     &nextPage,
-			})
-			panel.currentPageIdx = 0
+			return nil
+		// x for deleting compartment
+		case 'x':
+			if comp := panel.getSelectedCompartment(); comp != nil {
+				panel.showDeleteModal(comp)
+			}
+			return nil
+		// r for recovering deleted compartment
+		case 'r':
+			if comp := panel.getSelectedCompartment(); comp != nil {
+				panel.showRecoverModal(comp)
+			}
+			return nil
+		}
+		return event
+	})
+}
+
+// Reloads first page of compartments from OCI.
+func (panel *CompartmentPanel) reload() {
+	panel.guiController.SetLoading()
 
-			panel.refreshTable()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
 		}()
+		// if data already exists refresh
+		if panel.currentPageIdx > -1 {
+			panel.currentPageIdx = -1
+			panel.compartmentsPages = make([]compartmentsPage, 0)
+			panel.gui.mainTable.Clear()
+		}
+		compartments, nextPage, err := panel.ociController.ListCompartments(
+			panel.compartmentId,
+			panel.getCurrnetLimit(),
+			panel.getCurrentAccessLevel(),
+			panel.getCurrentSortBy(),
+			panel.getCurrentSortOrder(),
+			panel.getCurrentLifecycleState(),
+			"",
+		)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		p := ""
+		panel.compartmentsPages = append(panel.compartmentsPages, compartmentsPage{
+			page:         &p,
+			compartments: &compartments,
+			nextPage:     &nextPage,
+		})
+		panel.currentPageIdx = 0
 
-	})
+		panel.refreshTable()
+	}()
 }
 
 func (panel *CompartmentPanel) refreshTable() {
@@ -342,6 +382,16 @@ func (panel *CompartmentPanel) refreshTable() {
 		c := comp[row-1]
 		detail := NewCompartmentDetailPanel(&c)
 		panel.guiController.SetFocus(detail.freeTagTable)
+		NewTagEditor(panel.guiController, panel.ociController, panelName, detail.freeTagTable, detail.definedTagTable,
+			c.FreeformTags, c.DefinedTags,
+			func(freeTags map[string]string, definedTags map[string]map[string]interface{}) error {
+				updated, err := panel.ociController.UpdateCompartmentTags(*c.Id, freeTags, definedTags)
+				if err != nil {
+					return err
+				}
+				comp[row-1] = *updated
+				return nil
+			})
 		detail.freeTagTable.SetDoneFunc(func(key tcell.Key) {
 			if tcell.KeyTab == key {
 				panel.guiController.SetFocus(detail.definedTagTable)
@@ -364,6 +414,164 @@ func (panel *CompartmentPanel) refreshTable() {
 	})
 }
 
+func (panel *CompartmentPanel) getSelectedCompartment() *identity.Compartment {
+	if panel.currentPageIdx < 0 {
+		return nil
+	}
+	comps := *(panel.compartmentsPages[panel.currentPageIdx].compartments)
+	row, _ := panel.gui.mainTable.GetSelection()
+	if row < 1 || row > len(comps) {
+		return nil
+	}
+	return &comps[row-1]
+}
+
+// Reloads compartments of guiTopPanel dropdown after compartment tree was changed.
+func (panel *CompartmentPanel) reloadTopPanelCompartments() {
+	comps, err := panel.ociController.ListAllCompartments()
+	if err != nil {
+		panel.guiController.LogError(err.Error(), true)
+		return
+	}
+	panel.guiController.QueueUpdateDraw(func() {
+		panel.guiController.GetGUITopPanel().ReplaceCompartments(&comps)
+	})
+}
+
+// Reloads compartments table and top panel compartments.
+func (panel *CompartmentPanel) reloadAll() {
+	panel.reload()
+	go panel.reloadTopPanelCompartments()
+}
+
+func (panel *CompartmentPanel) showFormPanel(compartment *identity.Compartment) {
+	formPanel := NewCompartmentFormPanel(panel.guiController, panel.ociController, panel.tenancyId, panel.compartmentId, compartment)
+	formPanel.SetCloseFunc(func(changed bool) {
+		panel.guiController.RemovePage(formPanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if changed {
+			panel.reloadAll()
+		}
+	})
+	panel.guiController.AddPage(formPanel.GetPanelName(), formPanel.GetGUI(), true)
+	panel.guiController.SetFocus(formPanel.GetFocusPrimitive())
+}
+
+func (panel *CompartmentPanel) showMovePanel(compartment *identity.Compartment) {
+	resource := oci.ResourceSummary{
+		Type:           oci.ResourceCompartment,
+		Id:             *compartment.Id,
+		Name:           *compartment.Name,
+		CompartmentId:  *compartment.CompartmentId,
+		LifecycleState: string(compartment.LifecycleState),
+	}
+	movePanel := NewMoveResourcePanel(panel.guiController, panel.ociController, panel.tenancyId, resource)
+	movePanel.SetCloseFunc(func(moved bool) {
+		panel.guiController.RemovePage(movePanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if moved {
+			panel.reloadAll()
+		}
+	})
+	panel.guiController.AddPage(movePanel.GetPanelName(), movePanel.GetGUI(), true)
+	panel.guiController.SetFocus(movePanel.GetFocusPrimitive())
+}
+
+func (panel *CompartmentPanel) showDeleteModal(compartment *identity.Compartment) {
+	modalName := "ModalCompartmentDelete"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to delete compartment %s?\nCompartment has to be empty.", *compartment.Name)).
+		AddButtons([]string{"Delete", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			if buttonLabel != "Delete" {
+				return
+			}
+			panel.showProgress("Deleting compartment "+*compartment.Name, func(report func(progress string)) error {
+				return panel.ociController.DeleteCompartment(*compartment.Id, report)
+			})
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
+
+func (panel *CompartmentPanel) showRecoverModal(compartment *identity.Compartment) {
+	if compartment.LifecycleState != identity.CompartmentLifecycleStateDeleted {
+		panel.guiController.LogError("only deleted compartment can be recovered", true)
+		return
+	}
+	modalName := "ModalCompartmentRecover"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to recover compartment %s?", *compartment.Name)).
+		AddButtons([]string{"Recover", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			if buttonLabel != "Recover" {
+				return
+			}
+			panel.guiController.SetLoading()
+			go func() {
+				defer func() {
+					panel.guiController.RemoveLoading()
+					panel.guiController.RefreshGUI()
+				}()
+				if _, err := panel.ociController.RecoverCompartment(*compartment.Id); err != nil {
+					panel.guiController.LogError(err.Error(), true)
+					return
+				}
+				panel.reloadAll()
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
+
+// Shows progress of long running compartment operation tracked by work request.
+// Panel can be closed at any time, operation is then followed in background and only its failure is reported.
+// Compartments are reloaded when operation is finished.
+func (panel *CompartmentPanel) showProgress(title string, run func(report func(progress string)) error) {
+	panelName := "CompartmentProgressPanel"
+	// accessed only from GUI thread
+	closed := false
+	hint := "\n\nEsc: close, operation continues in background"
+	progressText := tview.NewTextView().SetDynamicColors(true)
+	progressText.SetBorder(true).SetTitle(title)
+	progressText.SetText("request sent" + hint)
+	progressText.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key && !closed {
+			closed = true
+			panel.guiController.RemovePage(panelName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+		}
+	})
+	grid := tview.NewGrid().SetColumns(0, 100, 0).SetRows(0, 7, 0)
+	grid.AddItem(progressText, 1, 1, 1, 1, 0, 0, true)
+	panel.guiController.AddPage(panelName, grid, true)
+	panel.guiController.SetFocus(progressText)
+	go func() {
+		err := run(func(progress string) {
+			panel.guiController.QueueUpdateDraw(func() {
+				if !closed {
+					progressText.SetText(tview.Escape(progress) + hint)
+				}
+			})
+		})
+		panel.guiController.QueueUpdateDraw(func() {
+			switch {
+			case err != nil && closed:
+				panel.guiController.LogError(title+": "+err.Error(), true)
+			case err != nil:
+				progressText.SetText("[red]" + tview.Escape(err.Error()) + "[white]\n\nEsc: close")
+			case !closed:
+				progressText.SetText("[green]finished[white]\n\nEsc: close")
+			}
+		})
+		if err == nil {
+			panel.reloadAll()
+		}
+	}()
+}
+
 func (panel *CompartmentPanel) accessibleToString(access *bool) (string, tcell.Color) {
 	if access == nil {
 		return "UNKNOWN", tcell.ColorYellow
@@ -438,5 +646,5 @@ func (panel *CompartmentPanel) Remove(pages *tview.Pages) {
 }
 
 func (panel *CompartmentPanel) GetInfo() string {
-	return "[red]Enter:[white] Details [red]Esc:[white] Exit"
+	return "[red]Enter:[white] Details [red]Esc:[white] Exit [green]n:[white] New [green]e:[white] Edit [green]v:[white] Move [green]x:[white] Delete [green]r:[white] Recover"
 }
@@ -0,0 +1,192 @@
+package gui
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/rivo/tview"
+)
+
+type domainsGUI struct {
+	mainGrid       *tview.Grid
+	refreshButton  *tview.Button
+	domainsTable   *tview.Table
+	workloadsTable *tview.Table
+}
+
+// Panel showing availability and fault domains of the region and how
+// compartment instances are distributed across them. Workloads with all
+// instances in one fault domain are flagged.
+type DomainsPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *domainsGUI
+	overview      *oci.DomainsOverview
+	tenancyId     string
+	compartmentId string
+}
+
+func NewDomainsPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *DomainsPanel {
+	res := DomainsPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newDomainsGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewDomainsAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewDomainsPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newDomainsGUI() *domainsGUI {
+	res := domainsGUI{
+		mainGrid:       tview.NewGrid(),
+		refreshButton:  tview.NewButton("Refresh"),
+		domainsTable:   tview.NewTable(),
+		workloadsTable: tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *DomainsPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 15, 0)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 1, 1, 1, 0, 0, false)
+
+	panel.gui.domainsTable.SetBorder(true).SetTitle("Fault Domains")
+	panel.gui.mainGrid.AddItem(panel.gui.domainsTable, 2, 0, 1, 3, 0, 0, false)
+	panel.gui.workloadsTable.SetBorder(true).SetTitle("Workloads Placement")
+	panel.gui.mainGrid.AddItem(panel.gui.workloadsTable, 3, 0, 1, 3, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *DomainsPanel) makeKeyBindings() {
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.domainsTable, panel.gui.domainsTable, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.domainsTable.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		case tcell.KeyTab:
+			panel.guiController.SetFocus(panel.gui.workloadsTable)
+		}
+	})
+	panel.gui.workloadsTable.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		case tcell.KeyBacktab:
+			panel.guiController.SetFocus(panel.gui.domainsTable)
+		}
+	})
+}
+
+// Reloads domains and instances of the compartment from OCI.
+func (panel *DomainsPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.domainsTable)
+			panel.guiController.RefreshGUI()
+		}()
+		overview, err := panel.ociController.GetDomainsOverview(panel.compartmentId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.overview = &overview
+		panel.refreshDomainsTable()
+		panel.refreshWorkloadsTable()
+	}()
+}
+
+func (panel *DomainsPanel) refreshDomainsTable() {
+	table := panel.gui.domainsTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"AVAILABILITY DOMAIN", "FAULT DOMAIN", "INSTANCES", "NAMES"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range panel.overview.FaultDomains {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		var names []string
+		for _, instance := range val.Instances {
+			names = append(names, *instance.DisplayName)
+		}
+		table.SetCell(row, 0, tview.NewTableCell(val.AvailabilityDomain).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(val.FaultDomain).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 2, tview.NewTableCell(strconv.Itoa(len(val.Instances))).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 3, tview.NewTableCell(tview.Escape(strings.Join(names, ", "))).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+	}
+}
+
+func (panel *DomainsPanel) refreshWorkloadsTable() {
+	table := panel.gui.workloadsTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"WORKLOAD", "INSTANCES", "FAULT DOMAINS", "STATUS"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	flagged := 0
+	for row, val := range panel.overview.Workloads {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		status, statusColor := "SPREAD", tcell.ColorGreen
+		if val.IsSingleFaultDomain {
+			status, statusColor = "SINGLE FAULT DOMAIN", tcell.ColorRed
+			flagged++
+		} else if len(val.Instances) == 1 {
+			status, statusColor = "SINGLE INSTANCE", tcell.ColorYellow
+		}
+		table.SetCell(row, 0, tview.NewTableCell(tview.Escape(val.Name)).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(strconv.Itoa(len(val.Instances))).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 2, tview.NewTableCell(strings.Join(val.FaultDomains, ", ")).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 3, tview.NewTableCell(status).SetAlign(tview.AlignCenter).SetTextColor(statusColor))
+	}
+	table.SetTitle("Workloads Placement (" + strconv.Itoa(flagged) + " in single fault domain)")
+}
+
+func (panel *DomainsPanel) GetPanelName() string {
+	return "domains"
+}
+
+func (panel *DomainsPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *DomainsPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *DomainsPanel) GetInfo() string {
+	return "[red]Esc:[white] Exit [green]Tab:[white] Switch table"
+}
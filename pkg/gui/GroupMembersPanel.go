@@ -0,0 +1,215 @@
+package gui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+// Panel showing members of IAM group and adding or removing users.
+// Writes are sent to home region of the tenancy by OCIController.
+type GroupMembersPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	group         oci.GroupOverview
+	users         []identity.User
+	candidates    []identity.User
+	grid          *tview.Grid
+	membersTable  *tview.Table
+	form          *tview.Form
+	userSelect    *tview.DropDown
+	changed       bool
+	closeFunc     func(changed bool)
+}
+
+func NewGroupMembersPanel(GuiController *GuiController, OciController *oci.OCIController, Group oci.GroupOverview) *GroupMembersPanel {
+	res := GroupMembersPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		group:         Group,
+		grid:          tview.NewGrid(),
+		membersTable:  tview.NewTable(),
+		form:          tview.NewForm(),
+		userSelect:    tview.NewDropDown().SetLabel("User:"),
+		closeFunc:     func(changed bool) {},
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *GroupMembersPanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *GroupMembersPanel) GetPanelName() string {
+	return "GroupMembersPanel"
+}
+
+func (panel *GroupMembersPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.membersTable
+}
+
+// Function called when panel is closed, changed is true if membership was modified.
+func (panel *GroupMembersPanel) SetCloseFunc(close func(changed bool)) {
+	panel.closeFunc = close
+}
+
+func (panel *GroupMembersPanel) createGUI() {
+	panel.membersTable.SetBorder(true).SetTitle("Members (d: remove)")
+	panel.membersTable.SetSelectable(true, false)
+	panel.membersTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(panel.form)
+		}
+		if tcell.KeyEscape == key {
+			panel.closeFunc(panel.changed)
+		}
+	})
+	panel.membersTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// d for removing selected user from group
+		if tcell.KeyRune == event.Key() && event.Rune() == 'd' {
+			row, _ := panel.membersTable.GetSelection()
+			if row >= 1 && row <= len(panel.group.Members) {
+				panel.remove(panel.group.Members[row-1])
+			}
+			return nil
+		}
+		return event
+	})
+
+	panel.form.AddFormItem(panel.userSelect).
+		AddButton("Add", panel.add).
+		AddButton("Close", func() { panel.closeFunc(panel.changed) })
+	panel.form.SetCancelFunc(func() { panel.closeFunc(panel.changed) })
+	panel.form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if tcell.KeyBacktab == event.Key() {
+			panel.guiController.SetFocus(panel.membersTable)
+			return nil
+		}
+		return event
+	})
+
+	grid := tview.NewGrid()
+	grid.SetColumns(0)
+	grid.SetRows(0, 5)
+	grid.AddItem(panel.membersTable, 0, 0, 1, 1, 0, 0, true)
+	grid.AddItem(panel.form, 1, 0, 1, 1, 0, 0, false)
+	grid.SetBorder(true).SetTitle("Group " + *panel.group.Group.Name)
+
+	panel.grid.SetColumns(0, 80, 0)
+	panel.grid.SetRows(0, 25, 0)
+	panel.grid.AddItem(grid, 1, 1, 1, 1, 0, 0, true)
+}
+
+// Loads users of the tenancy which can be added to the group.
+func (panel *GroupMembersPanel) LoadData() error {
+	users, err := panel.ociController.ListUsers()
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(users, func(i, j int) bool {
+		return strings.ToLower(*users[i].Name) < strings.ToLower(*users[j].Name)
+	})
+	panel.users = users
+	panel.refresh()
+	return nil
+}
+
+func (panel *GroupMembersPanel) refresh() {
+	table := panel.membersTable
+	table.Clear()
+	table.SetCell(0, 0, tview.NewTableCell("USER").SetAlign(tview.AlignCenter).SetSelectable(false))
+	table.SetCell(0, 1, tview.NewTableCell("OCID").SetAlign(tview.AlignCenter).SetSelectable(false))
+	members := make(map[string]bool)
+	for row, member := range panel.group.Members {
+		row += 1
+		members[member.UserId] = true
+		table.SetCell(row, 0, tview.NewTableCell(member.UserName))
+		table.SetCell(row, 1, tview.NewTableCell(member.UserId))
+	}
+	if len(panel.group.Members) == 0 {
+		table.SetCell(1, 0, tview.NewTableCell("No members.").SetSelectable(false))
+	}
+
+	panel.candidates = make([]identity.User, 0)
+	txt := make([]string, 0)
+	for _, user := range panel.users {
+		if !members[*user.Id] {
+			panel.candidates = append(panel.candidates, user)
+			txt = append(txt, *user.Name)
+		}
+	}
+	panel.userSelect.SetOptions(txt, nil)
+	if len(txt) > 0 {
+		panel.userSelect.SetCurrentOption(0)
+	}
+}
+
+func (panel *GroupMembersPanel) add() {
+	idx, _ := panel.userSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.candidates) {
+		return
+	}
+	user := panel.candidates[idx]
+	panel.confirm(fmt.Sprintf("Do you want to add %s to group %s?", *user.Name, *panel.group.Group.Name), "Add", panel.form, func() error {
+		membership, err := panel.ociController.AddUserToGroup(*user.Id, *panel.group.Group.Id)
+		if err != nil {
+			return err
+		}
+		panel.group.Members = append(panel.group.Members, oci.GroupMember{
+			MembershipId: *membership.Id,
+			UserId:       *user.Id,
+			UserName:     *user.Name,
+		})
+		return nil
+	})
+}
+
+func (panel *GroupMembersPanel) remove(member oci.GroupMember) {
+	panel.confirm(fmt.Sprintf("Do you want to remove %s from group %s?", member.UserName, *panel.group.Group.Name), "Remove", panel.membersTable, func() error {
+		if err := panel.ociController.RemoveUserFromGroup(member.MembershipId); err != nil {
+			return err
+		}
+		members := make([]oci.GroupMember, 0)
+		for _, m := range panel.group.Members {
+			if m.MembershipId != member.MembershipId {
+				members = append(members, m)
+			}
+		}
+		panel.group.Members = members
+		return nil
+	})
+}
+
+func (panel *GroupMembersPanel) confirm(question string, action string, focus tview.Primitive, apply func() error) {
+	modalName := "ModalGroupMembersPanel"
+	modal := tview.NewModal().
+		SetText(question).
+		AddButtons([]string{action, "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, panel.GetPanelName())
+			panel.guiController.SetFocus(focus)
+			if buttonLabel != action {
+				return
+			}
+			panel.guiController.SetLoading()
+			go func() {
+				defer func() {
+					panel.guiController.RemoveLoading()
+					panel.guiController.RefreshGUI()
+				}()
+				if err := apply(); err != nil {
+					panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), focus)
+					return
+				}
+				panel.changed = true
+				panel.refresh()
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
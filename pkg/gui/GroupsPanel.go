@@ -0,0 +1,250 @@
+package gui
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+const (
+	groupTypeGroups        = "GROUPS"
+	groupTypeDynamicGroups = "DYNAMIC GROUPS"
+)
+
+type groupsGUI struct {
+	mainGrid      *tview.Grid
+	typeDropDown  *tview.DropDown
+	refreshButton *tview.Button
+	mainTable     *tview.Table
+}
+
+// Panel listing groups with their members and dynamic groups with their matching rules.
+type GroupsPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *groupsGUI
+	groups        []oci.GroupOverview
+	dynamicGroups []identity.DynamicGroup
+	tenancyId     string
+	compartmentId string
+}
+
+func NewGroupsPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GroupsPanel {
+	res := GroupsPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newGroupsGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewGroupsAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewGroupsPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newGroupsGUI() *groupsGUI {
+	res := groupsGUI{
+		mainGrid:      tview.NewGrid(),
+		typeDropDown:  tview.NewDropDown(),
+		refreshButton: tview.NewButton("Refresh"),
+		mainTable:     tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *GroupsPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 20, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 30)
+	panel.gui.typeDropDown.SetBorder(true).SetTitle("Type")
+	panel.gui.mainGrid.AddItem(panel.gui.typeDropDown, 1, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 2, 1, 1, 0, 0, false)
+
+	panel.gui.mainTable.SetBorder(true).SetTitle("Groups Table")
+	panel.gui.mainGrid.AddItem(panel.gui.mainTable, 2, 0, 1, 4, 0, 0, false)
+
+	panel.gui.typeDropDown.SetOptions([]string{groupTypeGroups, groupTypeDynamicGroups}, func(text string, index int) {
+		if panel.groups != nil {
+			panel.refreshTable()
+		}
+	})
+	panel.gui.typeDropDown.SetCurrentOption(0)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *GroupsPanel) makeKeyBindings() {
+	panel.gui.typeDropDown.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.refreshButton, panel.gui.refreshButton, nil))
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.typeDropDown, panel.gui.typeDropDown, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.mainTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		}
+	})
+	panel.gui.mainTable.SetSelectedFunc(func(row, column int) {
+		if panel.isDynamicSelected() {
+			if row >= 1 && row <= len(panel.dynamicGroups) {
+				panel.showMatchingRule(panel.dynamicGroups[row-1])
+			}
+			return
+		}
+		if row >= 1 && row <= len(panel.groups) {
+			panel.showMembersPanel(panel.groups[row-1])
+		}
+	})
+}
+
+func (panel *GroupsPanel) isDynamicSelected() bool {
+	_, option := panel.gui.typeDropDown.GetCurrentOption()
+	return option == groupTypeDynamicGroups
+}
+
+// Reloads groups and dynamic groups from OCI.
+func (panel *GroupsPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
+		}()
+		groups, err := panel.ociController.ListGroupsOverview()
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		dynamicGroups, err := panel.ociController.ListDynamicGroups()
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		sort.SliceStable(groups, func(i, j int) bool {
+			return strings.ToLower(*groups[i].Group.Name) < strings.ToLower(*groups[j].Group.Name)
+		})
+		sort.SliceStable(dynamicGroups, func(i, j int) bool {
+			return strings.ToLower(*dynamicGroups[i].Name) < strings.ToLower(*dynamicGroups[j].Name)
+		})
+		panel.groups = groups
+		panel.dynamicGroups = dynamicGroups
+		panel.refreshTable()
+	}()
+}
+
+func (panel *GroupsPanel) refreshTable() {
+	table := panel.gui.mainTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+
+	rowColor := func(row int) tcell.Color {
+		if row%2 == 0 {
+			return tcell.ColorWhite
+		}
+		return tcell.ColorDarkGray
+	}
+
+	if panel.isDynamicSelected() {
+		for col, header := range []string{"NAME", "DESCRIPTION", "STATE", "MATCHING RULE"} {
+			table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+		}
+		for row, val := range panel.dynamicGroups {
+			row += 1
+			cellcolor := rowColor(row)
+			table.SetCell(row, 0, tview.NewTableCell(*val.Name).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+			table.SetCell(row, 1, tview.NewTableCell(tview.Escape(oci.StringOrEmpty(val.Description))).SetAlign(tview.AlignLeft).SetTextColor(cellcolor).SetMaxWidth(40))
+			table.SetCell(row, 2, tview.NewTableCell(string(val.LifecycleState)).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+			table.SetCell(row, 3, tview.NewTableCell(tview.Escape(oci.StringOrEmpty(val.MatchingRule))).SetAlign(tview.AlignLeft).SetTextColor(cellcolor).SetMaxWidth(80))
+		}
+		return
+	}
+
+	for col, header := range []string{"NAME", "DESCRIPTION", "STATE", "MEMBERS", "USERS"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range panel.groups {
+		row += 1
+		cellcolor := rowColor(row)
+		names := make([]string, 0)
+		for _, member := range val.Members {
+			names = append(names, member.UserName)
+		}
+		table.SetCell(row, 0, tview.NewTableCell(*val.Group.Name).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(oci.StringOrEmpty(val.Group.Description))).SetAlign(tview.AlignLeft).SetTextColor(cellcolor).SetMaxWidth(40))
+		table.SetCell(row, 2, tview.NewTableCell(string(val.Group.LifecycleState)).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 3, tview.NewTableCell(strconv.Itoa(len(val.Members))).SetAlign(tview.AlignRight).SetTextColor(cellcolor))
+		table.SetCell(row, 4, tview.NewTableCell(tview.Escape(strings.Join(names, ","))).SetAlign(tview.AlignLeft).SetTextColor(cellcolor).SetMaxWidth(60))
+	}
+}
+
+func (panel *GroupsPanel) showMembersPanel(group oci.GroupOverview) {
+	membersPanel := NewGroupMembersPanel(panel.guiController, panel.ociController, group)
+	membersPanel.SetCloseFunc(func(changed bool) {
+		panel.guiController.RemovePage(membersPanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if changed {
+			panel.reload()
+		}
+	})
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if err := membersPanel.LoadData(); err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.guiController.AddPage(membersPanel.GetPanelName(), membersPanel.GetGUI(), true)
+		panel.guiController.SetFocus(membersPanel.GetFocusPrimitive())
+	}()
+}
+
+func (panel *GroupsPanel) showMatchingRule(group identity.DynamicGroup) {
+	panelName := "DynamicGroupRulePanel"
+	text := tview.NewTextView().SetText(oci.StringOrEmpty(group.MatchingRule))
+	text.SetBorder(true).SetTitle("Matching rule of " + *group.Name)
+	text.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.RemovePage(panelName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+		}
+	})
+	outer := tview.NewGrid().SetColumns(0, 100, 0).SetRows(0, 15, 0)
+	outer.AddItem(text, 1, 1, 1, 1, 0, 0, true)
+	panel.guiController.AddPage(panelName, outer, true)
+	panel.guiController.SetFocus(text)
+}
+
+func (panel *GroupsPanel) GetPanelName() string {
+	return "groups"
+}
+
+func (panel *GroupsPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *GroupsPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *GroupsPanel) GetInfo() string {
+	return "[red]Enter:[white] Members or matching rule [red]Esc:[white] Exit"
+}
@@ -3,6 +3,8 @@ package gui
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -53,6 +55,11 @@ func (controller *GuiController) RefreshGUI() {
 	controller.application.Draw()
 }
 
+// Runs function in GUI thread and redraws screen, can be called from goroutines only.
+func (controller *GuiController) QueueUpdateDraw(f func()) {
+	controller.application.QueueUpdateDraw(f)
+}
+
 func (controller *GuiController) GetGUITopPanel() *guiTopPanel {
 	return controller.topPanel
 }
@@ -71,6 +78,10 @@ func (controller *GuiController) SetFocus(primitive tview.Primitive) {
 	controller.application.SetFocus(primitive)
 }
 
+func (controller *GuiController) GetFocus() tview.Primitive {
+	return controller.application.GetFocus()
+}
+
 func (controller *GuiController) GetBackFocusFunc() func() {
 	return func() {
 		controller.SetFocus(controller.topPanel.profileInput)
@@ -133,6 +144,20 @@ func (controller *GuiController) LogError(message string, modal bool) {
 	}
 }
 
+// Logs error and shows it in modal window on top of showPage.
+// After confirmation showPage is shown again and focus goes back to focus primitive.
+func (controller *GuiController) LogErrorOnPage(message string, showPage string, focus tview.Primitive) {
+	log.Print("ERROR " + message)
+	modalName := "ModalErrorWindow"
+	modal := tview.NewModal().SetText(message).
+		AddButtons([]string{"OK"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			controller.RemovePage(modalName, showPage)
+			controller.SetFocus(focus)
+		})
+	controller.AddPage(modalName, modal, false)
+}
+
 // Interface defining gui panel.
 // Designed for lists of entities.
 //
@@ -150,32 +175,175 @@ type GUIPanel interface {
 
 func getFreeTagTable(tags map[string]string) *tview.Table {
 	table := tview.NewTable()
+	fillFreeTagTable(table, tags)
+	table.SetBorder(true).SetTitle("Free Tags")
+	return table
+}
 
+// Fills table with free tags sorted by key, key is stored as reference of the row cells.
+func fillFreeTagTable(table *tview.Table, tags map[string]string) {
+	table.Clear()
 	table.SetCell(0, 0, tview.NewTableCell("TAG").SetAlign(tview.AlignCenter).SetSelectable(false))
 	table.SetCell(0, 1, tview.NewTableCell("VALUE").SetAlign(tview.AlignCenter).SetSelectable(false))
-	row := 1
-	for k, v := range tags {
-		table.SetCell(row, 0, tview.NewTableCell(k))
-		table.SetCell(row, 1, tview.NewTableCell(v))
+	keys := make([]string, 0)
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for row, k := range keys {
 		row += 1
+		table.SetCell(row, 0, tview.NewTableCell(tview.Escape(k)).SetReference(k))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(tags[k])).SetReference(k))
 	}
-	table.SetBorder(true).SetTitle("Free Tags")
-	return table
 }
 
 func getDefinedTagTable(tags map[string]map[string]interface{}) *tview.Table {
 	table := tview.NewTable()
+	fillDefinedTagTable(table, tags)
+	table.SetBorder(true).SetTitle("Defined Tags")
+	return table
+}
 
+// Fills table with defined tags sorted by namespace and key,
+// "namespace.key" is stored as reference of the row cells.
+func fillDefinedTagTable(table *tview.Table, tags map[string]map[string]interface{}) {
+	table.Clear()
 	table.SetCell(0, 0, tview.NewTableCell("TAG").SetAlign(tview.AlignCenter).SetSelectable(false))
 	table.SetCell(0, 1, tview.NewTableCell("VALUE").SetAlign(tview.AlignCenter).SetSelectable(false))
-	row := 1
-	for _, ts := range tags {
-		for v, t := range ts {
-			table.SetCell(row, 0, tview.NewTableCell(v))
-			table.SetCell(row, 1, tview.NewTableCell(t.(string)).SetSelectable(false))
-			row += 1
+	keys := make([]string, 0)
+	for ns, ts := range tags {
+		for k := range ts {
+			keys = append(keys, ns+"."+k)
 		}
 	}
-	table.SetBorder(true).SetTitle("Defined Tags")
-	return table
+	sort.Strings(keys)
+	for row, key := range keys {
+		row += 1
+		ns, k, _ := strings.Cut(key, ".")
+		table.SetCell(row, 0, tview.NewTableCell(tview.Escape(key)).SetReference(key))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(fmt.Sprintf("%v", tags[ns][k]))).SetReference(key))
+	}
+}
+
+// Splits text written as "key=value;key2=value2" into key value pairs.
+// Separators inside keys and values are escaped by backslash: \; \= and \\.
+// Text which does not parse unambiguously is rejected.
+func parseKeyValues(text string) ([][2]string, error) {
+	res := make([][2]string, 0)
+	var key, value strings.Builder
+	current := &key
+	assigned := false
+	pair := func() error {
+		k, v := strings.TrimSpace(key.String()), strings.TrimSpace(value.String())
+		if !assigned && k == "" {
+			return nil
+		}
+		if !assigned || k == "" {
+			return fmt.Errorf("%q should be written as key=value", k)
+		}
+		res = append(res, [2]string{k, v})
+		return nil
+	}
+	runes := []rune(text)
+	for idx := 0; idx < len(runes); idx++ {
+		switch r := runes[idx]; r {
+		case '\\':
+			if idx+1 >= len(runes) || !strings.ContainsRune(";=\\", runes[idx+1]) {
+				return nil, fmt.Errorf("backslash has to be followed by ;, = or \\")
+			}
+			idx++
+			current.WriteRune(runes[idx])
+		case '=':
+			if assigned {
+				return nil, fmt.Errorf("value of %q contains unescaped =", strings.TrimSpace(key.String()))
+			}
+			assigned = true
+			current = &value
+		case ';':
+			if err := pair(); err != nil {
+				return nil, err
+			}
+			key.Reset()
+			value.Reset()
+			current = &key
+			assigned = false
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if err := pair(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Escapes separators of key value text, inverse of unescaping done by parseKeyValues.
+func escapeKeyValue(text string) string {
+	return strings.NewReplacer("\\", "\\\\", ";", "\\;", "=", "\\=").Replace(text)
+}
+
+// Parses free tags written as "key=value;key2=value2", see parseKeyValues.
+func parseFreeTags(text string) (map[string]string, error) {
+	return parseKeyValueMap(text, "free tag")
+}
+
+// Parses key value pairs into map, what names the pairs in errors (e.g. free tag).
+func parseKeyValueMap(text string, what string) (map[string]string, error) {
+	pairs, err := parseKeyValues(text)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", what, err)
+	}
+	res := make(map[string]string)
+	for _, kv := range pairs {
+		if _, ok := res[kv[0]]; ok {
+			return nil, fmt.Errorf("%s %q is given twice", what, kv[0])
+		}
+		res[kv[0]] = kv[1]
+	}
+	return res, nil
+}
+
+// Parses defined tags written as "namespace.key=value;namespace.key2=value2", see parseKeyValues.
+func parseDefinedTags(text string) (map[string]map[string]interface{}, error) {
+	pairs, err := parseKeyValues(text)
+	if err != nil {
+		return nil, fmt.Errorf("defined tag: %w", err)
+	}
+	res := make(map[string]map[string]interface{})
+	for _, kv := range pairs {
+		nk := strings.SplitN(kv[0], ".", 2)
+		if len(nk) != 2 || nk[0] == "" || nk[1] == "" {
+			return nil, fmt.Errorf("defined tag %q should be written as namespace.key=value", kv[0])
+		}
+		if _, ok := res[nk[0]]; !ok {
+			res[nk[0]] = make(map[string]interface{})
+		}
+		if _, ok := res[nk[0]][nk[1]]; ok {
+			return nil, fmt.Errorf("defined tag %q is given twice", kv[0])
+		}
+		res[nk[0]][nk[1]] = kv[1]
+	}
+	return res, nil
+}
+
+// Formats free tags as "key=value;key2=value2" with escaped separators, inverse of parseFreeTags.
+func formatFreeTags(tags map[string]string) string {
+	pairs := make([]string, 0)
+	for k, v := range tags {
+		pairs = append(pairs, escapeKeyValue(k)+"="+escapeKeyValue(v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ";")
+}
+
+// Formats defined tags as "namespace.key=value;..." with escaped separators, inverse of parseDefinedTags.
+func formatDefinedTags(tags map[string]map[string]interface{}) string {
+	pairs := make([]string, 0)
+	for ns, ts := range tags {
+		for k, v := range ts {
+			pairs = append(pairs, escapeKeyValue(ns+"."+k)+"="+escapeKeyValue(fmt.Sprintf("%v", v)))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ";")
 }
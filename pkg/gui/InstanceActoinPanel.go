@@ -7,6 +7,12 @@ import (
 	"github.com/rivo/tview"
 )
 
+// Not power actions, handled by their own panels.
+const (
+	instanceActionTerminate          = "TERMINATE"
+	instanceActionWindowsCredentials = "WINDOWS CREDENTIALS"
+)
+
 type InstanceActionPanel struct {
 	grid          *tview.Grid
 	instance      *core.Instance
@@ -28,11 +34,22 @@ func (panel *InstanceActionPanel) GetSelectedAction() core.InstanceActionActionE
 	return panel.actionMap[option]
 }
 
+func (panel *InstanceActionPanel) IsTerminateSelected() bool {
+	_, option := panel.actionSelect.GetCurrentOption()
+	return option == instanceActionTerminate
+}
+
+func (panel *InstanceActionPanel) IsWindowsCredentialsSelected() bool {
+	_, option := panel.actionSelect.GetCurrentOption()
+	return option == instanceActionWindowsCredentials
+}
+
 func (panel *InstanceActionPanel) GetInstanceOCID() string {
 	return *panel.instance.Id
 }
 
-func NewInstanceActionPanel(instance *core.Instance) *InstanceActionPanel {
+// Windows credentials action is offered only when windows is set.
+func NewInstanceActionPanel(instance *core.Instance, windows bool) *InstanceActionPanel {
 	res := InstanceActionPanel{
 		grid:     tview.NewGrid(),
 		instance: instance,
@@ -41,7 +58,7 @@ func NewInstanceActionPanel(instance *core.Instance) *InstanceActionPanel {
 	ocid := tview.NewInputField().SetLabel("OCID:").SetText(*instance.Id)
 	name := tview.NewInputField().SetLabel("Name:").SetText(*instance.DisplayName)
 	lifecycle := tview.NewInputField().SetLabel("Lifecycle:").SetText(string(instance.LifecycleState))
-	res.actionSelect, res.actionMap = instanceActionToDropDown()
+	res.actionSelect, res.actionMap = instanceActionToDropDown(windows)
 	res.executeButton = tview.NewButton("Execute")
 
 	grid := tview.NewGrid()
@@ -63,7 +80,7 @@ func NewInstanceActionPanel(instance *core.Instance) *InstanceActionPanel {
 	return &res
 }
 
-func instanceActionToDropDown() (*tview.DropDown, map[string]core.InstanceActionActionEnum) {
+func instanceActionToDropDown(windows bool) (*tview.DropDown, map[string]core.InstanceActionActionEnum) {
 	res := tview.NewDropDown().SetLabel("Select Action:")
 	actionsStr := make([]string, 0)
 	actionMap := make(map[string]core.InstanceActionActionEnum)
@@ -73,6 +90,10 @@ func instanceActionToDropDown() (*tview.DropDown, map[string]core.InstanceAction
 	}
 
 	sort.Strings(actionsStr)
+	actionsStr = append(actionsStr, instanceActionTerminate)
+	if windows {
+		actionsStr = append(actionsStr, instanceActionWindowsCredentials)
+	}
 	res.SetOptions(actionsStr, nil)
 	res.SetCurrentOption(0)
 	return res, actionMap
@@ -0,0 +1,130 @@
+package gui
+
+import (
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/core"
+	"github.com/rivo/tview"
+)
+
+// Panel showing initial credentials of Windows instance.
+// Password is masked until revealed and is never written to logs.
+type InstanceCredentialsPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	instance      *core.Instance
+	grid          *tview.Grid
+	form          *tview.Form
+	userInput     *tview.InputField
+	passwordInput *tview.InputField
+	statusText    *tview.TextView
+	username      string
+	password      string
+	revealed      bool
+	closeFunc     func()
+}
+
+func NewInstanceCredentialsPanel(GuiController *GuiController, OciController *oci.OCIController, Instance *core.Instance) *InstanceCredentialsPanel {
+	res := InstanceCredentialsPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		instance:      Instance,
+		grid:          tview.NewGrid(),
+		form:          tview.NewForm(),
+		userInput:     tview.NewInputField().SetLabel("Username:").SetFieldWidth(40),
+		passwordInput: tview.NewInputField().SetLabel("Password:").SetFieldWidth(40),
+		statusText:    tview.NewTextView(),
+		closeFunc:     func() {},
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *InstanceCredentialsPanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *InstanceCredentialsPanel) GetPanelName() string {
+	return "InstanceCredentialsPanel"
+}
+
+func (panel *InstanceCredentialsPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+func (panel *InstanceCredentialsPanel) SetCloseFunc(close func()) {
+	panel.closeFunc = close
+}
+
+func (panel *InstanceCredentialsPanel) createGUI() {
+	// fields are read only, edits (including Backspace and Delete) are reverted
+	panel.userInput.SetChangedFunc(func(text string) {
+		if text != panel.username {
+			panel.userInput.SetText(panel.username)
+		}
+	})
+	panel.passwordInput.SetChangedFunc(func(text string) {
+		if text != panel.password {
+			panel.passwordInput.SetText(panel.password)
+		}
+	})
+	panel.passwordInput.SetMaskCharacter('*')
+	panel.statusText.SetDynamicColors(true)
+
+	panel.form.AddFormItem(panel.userInput).
+		AddFormItem(panel.passwordInput).
+		AddButton("Reveal", panel.toggleReveal).
+		AddButton("Copy password", panel.copyPassword).
+		AddButton("Close", panel.close)
+	panel.form.SetCancelFunc(panel.close)
+
+	grid := tview.NewGrid()
+	grid.SetColumns(0)
+	grid.SetRows(7, 1)
+	grid.AddItem(panel.form, 0, 0, 1, 1, 0, 0, true)
+	grid.AddItem(panel.statusText, 1, 0, 1, 1, 0, 0, false)
+	grid.SetBorder(true).SetTitle("Windows Initial Credentials of " + *panel.instance.DisplayName)
+
+	panel.grid.SetColumns(0, 80, 0)
+	panel.grid.SetRows(0, 10, 0)
+	panel.grid.AddItem(grid, 1, 1, 1, 1, 0, 0, true)
+}
+
+// Fetches credentials of the instance.
+func (panel *InstanceCredentialsPanel) LoadData() error {
+	credentials, err := panel.ociController.GetWindowsInstanceInitialCredentials(*panel.instance.Id)
+	if err != nil {
+		return err
+	}
+	panel.username = oci.StringOrEmpty(credentials.Username)
+	panel.password = oci.StringOrEmpty(credentials.Password)
+	panel.userInput.SetText(panel.username)
+	panel.passwordInput.SetText(panel.password)
+	return nil
+}
+
+func (panel *InstanceCredentialsPanel) toggleReveal() {
+	panel.revealed = !panel.revealed
+	if panel.revealed {
+		panel.passwordInput.SetMaskCharacter(0)
+		panel.form.GetButton(panel.form.GetButtonIndex("Reveal")).SetLabel("Hide")
+	} else {
+		panel.passwordInput.SetMaskCharacter('*')
+		panel.form.GetButton(panel.form.GetButtonIndex("Hide")).SetLabel("Reveal")
+	}
+}
+
+// Copies password to clipboard, the password itself is never logged.
+func (panel *InstanceCredentialsPanel) copyPassword() {
+	if err := copyToClipboard(panel.password); err != nil {
+		panel.statusText.SetText("[red]password could not be copied: " + tview.Escape(err.Error()))
+		return
+	}
+	panel.statusText.SetText("[green]password copied to clipboard")
+}
+
+func (panel *InstanceCredentialsPanel) close() {
+	// do not keep password in memory longer than necessary
+	panel.password = ""
+	panel.passwordInput.SetText("")
+	panel.closeFunc()
+}
@@ -1,6 +1,10 @@
 package gui
 
 import (
+	"strconv"
+	"strings"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
 	"github.com/oracle/oci-go-sdk/v52/core"
 	"github.com/rivo/tview"
 )
@@ -10,6 +14,9 @@ type InstanceDetailPanel struct {
 	instance        *core.Instance
 	freeTagTable    *tview.Table
 	definedTagTable *tview.Table
+	networkTable    *tview.Table
+	storageTable    *tview.Table
+	volumes         []oci.InstanceVolume
 }
 
 func (panel *InstanceDetailPanel) GetGUI() tview.Primitive {
@@ -33,7 +40,8 @@ func NewInstanceDetailPanel(instance *core.Instance) *InstanceDetailPanel {
 
 	grid := tview.NewGrid()
 	grid.SetColumns(50, 50)
-	grid.SetRows(1, 1, 1, 1, 1, 8)
+	rows := []int{1, 1, 1, 1, 1, 8, 7, 7}
+	grid.SetRows(rows...)
 
 	grid.AddItem(ocid, 0, 0, 1, 2, 0, 0, false)
 	grid.AddItem(compId, 1, 0, 1, 2, 0, 0, false)
@@ -50,11 +58,108 @@ func NewInstanceDetailPanel(instance *core.Instance) *InstanceDetailPanel {
 	grid.AddItem(res.freeTagTable, 5, 0, 1, 1, 0, 0, true)
 	grid.AddItem(res.definedTagTable, 5, 1, 1, 1, 0, 0, false)
 
-	grid.SetBorder(true).SetTitle("Compartment Details")
+	res.networkTable = tview.NewTable()
+	res.networkTable.SetBorder(true).SetTitle("Networking")
+	res.networkTable.SetCell(0, 0, tview.NewTableCell("Loading ...").SetSelectable(false))
+	grid.AddItem(res.networkTable, 6, 0, 1, 2, 0, 0, false)
+
+	res.storageTable = tview.NewTable()
+	res.storageTable.SetBorder(true).SetTitle("Storage (a: attach, d: detach)")
+	res.storageTable.SetCell(0, 0, tview.NewTableCell("Loading ...").SetSelectable(false))
+	grid.AddItem(res.storageTable, 7, 0, 1, 2, 0, 0, false)
+
+	grid.SetBorder(true).SetTitle("Instance Details (u: metadata)")
 
 	res.grid.SetColumns(0, 100, 0)
-	res.grid.SetRows(0, 15, 0)
+	// inner rows plus top and bottom border
+	height := 2
+	for _, row := range rows {
+		height += row
+	}
+	res.grid.SetRows(0, height, 0)
 	res.grid.AddItem(grid, 1, 1, 1, 1, 0, 0, false)
 
 	return &res
 }
+
+// Fills networking table with VNICs of the instance, one row per IP address.
+func (panel *InstanceDetailPanel) SetNetworking(vnics []oci.VnicDetails) {
+	table := panel.networkTable
+	table.Clear()
+	table.SetSelectable(true, false)
+	for col, header := range []string{"VNIC", "PRIVATE IP", "PUBLIC IP", "FQDN", "SUBNET", "VCN", "NSG"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	row := 1
+	for _, vnic := range vnics {
+		name := oci.StringOrEmpty(vnic.Vnic.DisplayName)
+		if vnic.Vnic.IsPrimary != nil && *vnic.Vnic.IsPrimary {
+			name += " (primary)"
+		}
+		ips := vnic.Ips
+		if len(ips) == 0 {
+			// VNIC without listed IPs still gets its own row
+			ips = []oci.VnicIp{{}}
+		}
+		for _, ip := range ips {
+			table.SetCell(row, 0, tview.NewTableCell(name))
+			table.SetCell(row, 1, tview.NewTableCell(ip.PrivateIp))
+			table.SetCell(row, 2, tview.NewTableCell(ip.PublicIp))
+			table.SetCell(row, 3, tview.NewTableCell(vnic.Fqdn))
+			table.SetCell(row, 4, tview.NewTableCell(vnic.SubnetName+" "+vnic.SubnetCidr))
+			table.SetCell(row, 5, tview.NewTableCell(vnic.VcnName))
+			table.SetCell(row, 6, tview.NewTableCell(strings.Join(vnic.NsgNames, ",")))
+			row += 1
+		}
+	}
+	if row == 1 {
+		table.SetCell(row, 0, tview.NewTableCell("No VNICs attached.").SetSelectable(false))
+	}
+}
+
+// Shows error instead of networking table content.
+func (panel *InstanceDetailPanel) SetNetworkingError(message string) {
+	panel.networkTable.Clear()
+	panel.networkTable.SetCell(0, 0, tview.NewTableCell("[red]"+tview.Escape(message)).SetSelectable(false))
+}
+
+// Fills storage table with boot volume and block volumes attached to the instance.
+func (panel *InstanceDetailPanel) SetStorage(volumes []oci.InstanceVolume) {
+	panel.volumes = volumes
+	table := panel.storageTable
+	table.Clear()
+	table.SetSelectable(true, false)
+	for col, header := range []string{"NAME", "SIZE GB", "VPUS/GB", "TYPE", "STATE", "DEVICE", "ISCSI TARGET"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, volume := range volumes {
+		row += 1
+		name := volume.Name
+		if volume.IsReadOnly {
+			name += " (ro)"
+		}
+		table.SetCell(row, 0, tview.NewTableCell(name))
+		table.SetCell(row, 1, tview.NewTableCell(strconv.FormatInt(volume.SizeInGBs, 10)).SetAlign(tview.AlignRight))
+		table.SetCell(row, 2, tview.NewTableCell(strconv.FormatInt(volume.VpusPerGB, 10)).SetAlign(tview.AlignRight))
+		table.SetCell(row, 3, tview.NewTableCell(volume.AttachmentType))
+		table.SetCell(row, 4, tview.NewTableCell(volume.AttachmentState))
+		table.SetCell(row, 5, tview.NewTableCell(volume.Device))
+		table.SetCell(row, 6, tview.NewTableCell(volume.IscsiTarget))
+	}
+}
+
+// Shows error instead of storage table content.
+func (panel *InstanceDetailPanel) SetStorageError(message string) {
+	panel.volumes = nil
+	panel.storageTable.Clear()
+	panel.storageTable.SetCell(0, 0, tview.NewTableCell("[red]"+tview.Escape(message)).SetSelectable(false))
+}
+
+// Returns volume selected in storage table, nil if nothing is selected.
+func (panel *InstanceDetailPanel) GetSelectedVolume() *oci.InstanceVolume {
+	row, _ := panel.storageTable.GetSelection()
+	if row < 1 || row > len(panel.volumes) {
+		return nil
+	}
+	return &panel.volumes[row-1]
+}
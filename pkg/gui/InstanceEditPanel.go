@@ -0,0 +1,297 @@
+package gui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/core"
+	"github.com/rivo/tview"
+)
+
+// Panel editing compute instance: name, shape, tags and extended metadata.
+// Shows before/after diff of changes and warns if change will reboot instance.
+type InstanceEditPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	instance      *core.Instance
+	shapes        []core.Shape
+	grid          *tview.Grid
+	form          *tview.Form
+	diffText      *tview.TextView
+	nameInput     *tview.InputField
+	shapeSelect   *tview.DropDown
+	ocpusInput    *tview.InputField
+	memoryInput   *tview.InputField
+	freeTags      *tview.InputField
+	definedTags   *tview.InputField
+	metadataInput *tview.InputField
+	closeFunc     func(instance *core.Instance)
+}
+
+func NewInstanceEditPanel(GuiController *GuiController, OciController *oci.OCIController, Instance *core.Instance) *InstanceEditPanel {
+	res := InstanceEditPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		instance:      Instance,
+		grid:          tview.NewGrid(),
+		form:          tview.NewForm(),
+		diffText:      tview.NewTextView(),
+		nameInput:     tview.NewInputField().SetLabel("Name:").SetFieldWidth(50),
+		shapeSelect:   tview.NewDropDown().SetLabel("Shape:"),
+		ocpusInput:    tview.NewInputField().SetLabel("OCPUs (flex):").SetFieldWidth(10),
+		memoryInput:   tview.NewInputField().SetLabel("Memory GB (flex):").SetFieldWidth(10),
+		freeTags:      tview.NewInputField().SetLabel("Free tags:").SetFieldWidth(70),
+		definedTags:   tview.NewInputField().SetLabel("Defined tags:").SetFieldWidth(70),
+		metadataInput: tview.NewInputField().SetLabel("Extended metadata:").SetFieldWidth(70),
+		closeFunc:     func(instance *core.Instance) {},
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *InstanceEditPanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *InstanceEditPanel) GetPanelName() string {
+	return "InstanceEditPanel"
+}
+
+func (panel *InstanceEditPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+// Function called when panel is closed, instance is not nil if it was updated.
+func (panel *InstanceEditPanel) SetCloseFunc(close func(instance *core.Instance)) {
+	panel.closeFunc = close
+}
+
+func (panel *InstanceEditPanel) createGUI() {
+	panel.ocpusInput.SetAcceptanceFunc(tview.InputFieldFloat)
+	panel.memoryInput.SetAcceptanceFunc(tview.InputFieldFloat)
+	panel.form.AddFormItem(panel.nameInput).
+		AddFormItem(panel.shapeSelect).
+		AddFormItem(panel.ocpusInput).
+		AddFormItem(panel.memoryInput).
+		AddFormItem(panel.freeTags).
+		AddFormItem(panel.definedTags).
+		AddFormItem(panel.metadataInput).
+		AddButton("Preview", panel.preview).
+		AddButton("Save", panel.save).
+		AddButton("Cancel", func() { panel.closeFunc(nil) })
+	panel.form.SetCancelFunc(func() { panel.closeFunc(nil) })
+
+	panel.diffText.SetDynamicColors(true).SetBorder(true).SetTitle("Changes")
+
+	grid := tview.NewGrid()
+	grid.SetColumns(0)
+	grid.SetRows(17, 0)
+	grid.AddItem(panel.form, 0, 0, 1, 1, 0, 0, true)
+	grid.AddItem(panel.diffText, 1, 0, 1, 1, 0, 0, false)
+	grid.SetBorder(true).SetTitle("Edit Instance")
+
+	panel.grid.SetColumns(0, 110, 0)
+	panel.grid.SetRows(0, 30, 0)
+	panel.grid.AddItem(grid, 1, 1, 1, 1, 0, 0, true)
+
+	panel.nameInput.SetText(oci.StringOrEmpty(panel.instance.DisplayName))
+	panel.freeTags.SetText(formatFreeTags(panel.instance.FreeformTags))
+	panel.definedTags.SetText(formatDefinedTags(panel.instance.DefinedTags))
+	panel.metadataInput.SetText(formatFreeTags(stringExtendedMetadata(panel.instance.ExtendedMetadata)))
+	if panel.instance.ShapeConfig != nil && panel.instance.ShapeConfig.Ocpus != nil && panel.instance.ShapeConfig.MemoryInGBs != nil {
+		panel.ocpusInput.SetText(fmt.Sprintf("%g", *panel.instance.ShapeConfig.Ocpus))
+		panel.memoryInput.SetText(fmt.Sprintf("%g", *panel.instance.ShapeConfig.MemoryInGBs))
+	}
+}
+
+// Loads shapes available in instance availability domain.
+func (panel *InstanceEditPanel) LoadData() error {
+	shapes, err := panel.ociController.ListShapes(*panel.instance.CompartmentId, *panel.instance.AvailabilityDomain)
+	if err != nil {
+		return err
+	}
+	panel.shapes = shapes
+	selIdx := 0
+	txt := make([]string, 0)
+	for idx, shape := range shapes {
+		txt = append(txt, *shape.Shape)
+		if *shape.Shape == *panel.instance.Shape {
+			selIdx = idx
+		}
+	}
+	panel.shapeSelect.SetOptions(txt, nil)
+	panel.shapeSelect.SetCurrentOption(selIdx)
+	return nil
+}
+
+// Only string values of extended metadata can be edited, nested values are kept untouched.
+func stringExtendedMetadata(metadata map[string]interface{}) map[string]string {
+	res := make(map[string]string)
+	for k, v := range metadata {
+		if str, ok := v.(string); ok {
+			res[k] = str
+		}
+	}
+	return res
+}
+
+func (panel *InstanceEditPanel) getSelectedShape() *core.Shape {
+	idx, _ := panel.shapeSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.shapes) {
+		return nil
+	}
+	return &panel.shapes[idx]
+}
+
+// Builds update details containing only changed fields and human readable diff.
+func (panel *InstanceEditPanel) getUpdateDetails() (details *core.UpdateInstanceDetails, diff []string, reboot bool, err error) {
+	details = &core.UpdateInstanceDetails{}
+	diff = make([]string, 0)
+	addDiff := func(field string, before string, after string) {
+		diff = append(diff, fmt.Sprintf("[yellow]%s:[white] [red]%s[white] -> [green]%s[white]", field, tview.Escape(before), tview.Escape(after)))
+	}
+
+	name := strings.TrimSpace(panel.nameInput.GetText())
+	if name == "" {
+		return nil, nil, false, fmt.Errorf("instance name can not be empty")
+	}
+	if name != oci.StringOrEmpty(panel.instance.DisplayName) {
+		details.DisplayName = common.String(name)
+		addDiff("Name", oci.StringOrEmpty(panel.instance.DisplayName), name)
+	}
+
+	shape := panel.getSelectedShape()
+	if shape != nil && *shape.Shape != *panel.instance.Shape {
+		details.Shape = shape.Shape
+		addDiff("Shape", *panel.instance.Shape, *shape.Shape)
+		reboot = true
+	}
+	if isFlexShape(shape) {
+		ocpus, err := strconv.ParseFloat(panel.ocpusInput.GetText(), 32)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("OCPUs has to be provided for flex shape")
+		}
+		memory, err := strconv.ParseFloat(panel.memoryInput.GetText(), 32)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("memory has to be provided for flex shape")
+		}
+		var oldOcpus, oldMemory float32
+		if panel.instance.ShapeConfig != nil && panel.instance.ShapeConfig.Ocpus != nil && panel.instance.ShapeConfig.MemoryInGBs != nil {
+			oldOcpus = *panel.instance.ShapeConfig.Ocpus
+			oldMemory = *panel.instance.ShapeConfig.MemoryInGBs
+		}
+		if details.Shape != nil || float32(ocpus) != oldOcpus || float32(memory) != oldMemory {
+			details.ShapeConfig = &core.UpdateInstanceShapeConfigDetails{
+				Ocpus:       common.Float32(float32(ocpus)),
+				MemoryInGBs: common.Float32(float32(memory)),
+			}
+			addDiff("Shape config", fmt.Sprintf("%g OCPU %g GB", oldOcpus, oldMemory), fmt.Sprintf("%g OCPU %g GB", ocpus, memory))
+			reboot = true
+		}
+	}
+
+	freeTags, err := parseFreeTags(panel.freeTags.GetText())
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if formatFreeTags(freeTags) != formatFreeTags(panel.instance.FreeformTags) {
+		details.FreeformTags = freeTags
+		addDiff("Free tags", formatFreeTags(panel.instance.FreeformTags), formatFreeTags(freeTags))
+	}
+
+	definedTags, err := parseDefinedTags(panel.definedTags.GetText())
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if formatDefinedTags(definedTags) != formatDefinedTags(panel.instance.DefinedTags) {
+		details.DefinedTags = definedTags
+		addDiff("Defined tags", formatDefinedTags(panel.instance.DefinedTags), formatDefinedTags(definedTags))
+	}
+
+	metadata, err := parseKeyValueMap(panel.metadataInput.GetText(), "metadata")
+	if err != nil {
+		return nil, nil, false, err
+	}
+	oldMetadata := stringExtendedMetadata(panel.instance.ExtendedMetadata)
+	if formatFreeTags(metadata) != formatFreeTags(oldMetadata) {
+		extended := make(map[string]interface{})
+		for k, v := range panel.instance.ExtendedMetadata {
+			if _, ok := v.(string); !ok {
+				extended[k] = v
+			}
+		}
+		for k, v := range metadata {
+			extended[k] = v
+		}
+		details.ExtendedMetadata = extended
+		addDiff("Extended metadata", formatFreeTags(oldMetadata), formatFreeTags(metadata))
+	}
+
+	reboot = reboot && panel.instance.LifecycleState == core.InstanceLifecycleStateRunning
+	return details, diff, reboot, nil
+}
+
+func (panel *InstanceEditPanel) preview() {
+	_, diff, reboot, err := panel.getUpdateDetails()
+	if err != nil {
+		panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+		return
+	}
+	panel.showDiff(diff, reboot)
+}
+
+func (panel *InstanceEditPanel) showDiff(diff []string, reboot bool) {
+	if len(diff) == 0 {
+		panel.diffText.SetText("No changes.")
+		return
+	}
+	txt := strings.Join(diff, "\n")
+	if reboot {
+		txt = "[red]WARNING: changing shape of running instance will reboot it.[white]\n" + txt
+	}
+	panel.diffText.SetText(txt)
+}
+
+func (panel *InstanceEditPanel) save() {
+	details, diff, reboot, err := panel.getUpdateDetails()
+	if err != nil {
+		panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+		return
+	}
+	panel.showDiff(diff, reboot)
+	if len(diff) == 0 {
+		return
+	}
+	question := fmt.Sprintf("Do you want to apply %d change(s)?", len(diff))
+	if reboot {
+		question += "\nInstance will be rebooted."
+	}
+	modalName := "ModalInstanceEditPanel"
+	modal := tview.NewModal().
+		SetText(question).
+		AddButtons([]string{"Save", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, panel.GetPanelName())
+			panel.guiController.SetFocus(panel.form)
+			if buttonLabel != "Save" {
+				return
+			}
+			panel.guiController.SetLoading()
+			go func() {
+				defer func() {
+					panel.guiController.RemoveLoading()
+					panel.guiController.RefreshGUI()
+				}()
+				instance, err := panel.ociController.UpdateInstance(*panel.instance.Id, *details)
+				if err != nil {
+					panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+					return
+				}
+				panel.closeFunc(instance)
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
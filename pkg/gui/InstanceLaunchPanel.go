@@ -0,0 +1,581 @@
+package gui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/core"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+const (
+	launchStepPlacement = "placement"
+	launchStepShape     = "shape"
+	launchStepSource    = "source"
+	launchStepAccess    = "access"
+	launchStepSummary   = "summary"
+)
+
+type instanceLaunchGUI struct {
+	mainGrid  *tview.Grid
+	steps     *tview.Pages
+	infoText  *tview.TextView
+	placement *tview.Form
+	shape     *tview.Form
+	source    *tview.Form
+	access    *tview.Form
+	summary   *tview.Form
+
+	nameInput         *tview.InputField
+	compartmentSelect *tview.DropDown
+	adSelect          *tview.DropDown
+	shapeSelect       *tview.DropDown
+	ocpusInput        *tview.InputField
+	memoryInput       *tview.InputField
+	imageSelect       *tview.DropDown
+	subnetSelect      *tview.DropDown
+	publicIpCheck     *tview.Checkbox
+	sshKeyInput       *tview.InputField
+	bootSizeInput     *tview.InputField
+	freeTagsInput     *tview.InputField
+	definedTagsInput  *tview.InputField
+	summaryText       *tview.TextView
+}
+
+// Multi-step wizard launching new compute instance.
+// Placement -> Shape -> Image and network -> Access and storage -> Summary.
+type InstanceLaunchPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *instanceLaunchGUI
+	compartments  []identity.Compartment
+	ads           []identity.AvailabilityDomain
+	shapes        []core.Shape
+	images        []core.Image
+	subnets       []core.Subnet
+	compartmentId string
+	launched      *core.Instance
+	closeFunc     func()
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+func NewInstanceLaunchPanel(GuiController *GuiController, OciController *oci.OCIController, CompartmentId string) *InstanceLaunchPanel {
+	res := InstanceLaunchPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		gui:           newInstanceLaunchGUI(),
+		compartments:  GuiController.GetGUITopPanel().GetCompartments(),
+		compartmentId: CompartmentId,
+		closeFunc:     func() {},
+	}
+	res.ctx, res.cancel = context.WithCancel(context.Background())
+	res.createGUI()
+	return &res
+}
+
+func newInstanceLaunchGUI() *instanceLaunchGUI {
+	res := instanceLaunchGUI{
+		mainGrid:          tview.NewGrid(),
+		steps:             tview.NewPages(),
+		infoText:          tview.NewTextView(),
+		placement:         tview.NewForm(),
+		shape:             tview.NewForm(),
+		source:            tview.NewForm(),
+		access:            tview.NewForm(),
+		summary:           tview.NewForm(),
+		nameInput:         tview.NewInputField().SetLabel("Name:").SetFieldWidth(50),
+		compartmentSelect: tview.NewDropDown().SetLabel("Compartment:"),
+		adSelect:          tview.NewDropDown().SetLabel("Availability Domain:"),
+		shapeSelect:       tview.NewDropDown().SetLabel("Shape:"),
+		ocpusInput:        tview.NewInputField().SetLabel("OCPUs (flex):").SetFieldWidth(10),
+		memoryInput:       tview.NewInputField().SetLabel("Memory GB (flex):").SetFieldWidth(10),
+		imageSelect:       tview.NewDropDown().SetLabel("Image:"),
+		subnetSelect:      tview.NewDropDown().SetLabel("Subnet:"),
+		publicIpCheck:     tview.NewCheckbox().SetLabel("Assign public IP:"),
+		sshKeyInput:       tview.NewInputField().SetLabel("SSH public key:").SetFieldWidth(70),
+		bootSizeInput:     tview.NewInputField().SetLabel("Boot volume GB:").SetFieldWidth(10),
+		freeTagsInput:     tview.NewInputField().SetLabel("Free tags:").SetFieldWidth(70).SetPlaceholder("key=value;key2=value2"),
+		definedTagsInput:  tview.NewInputField().SetLabel("Defined tags:").SetFieldWidth(70).SetPlaceholder("namespace.key=value"),
+		summaryText:       tview.NewTextView(),
+	}
+	return &res
+}
+
+func (panel *InstanceLaunchPanel) GetGUI() tview.Primitive {
+	return panel.gui.mainGrid
+}
+
+func (panel *InstanceLaunchPanel) GetPanelName() string {
+	return "InstanceLaunchPanel"
+}
+
+// Function called when wizard is closed.
+func (panel *InstanceLaunchPanel) SetCloseFunc(close func()) {
+	panel.closeFunc = close
+}
+
+// Returns instance launched by wizard, nil if nothing was launched.
+func (panel *InstanceLaunchPanel) GetLaunchedInstance() *core.Instance {
+	return panel.launched
+}
+
+// Focus primitive that should be focused after wizard is shown.
+func (panel *InstanceLaunchPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.gui.placement
+}
+
+func (panel *InstanceLaunchPanel) createGUI() {
+	panel.gui.placement.AddFormItem(panel.gui.nameInput).
+		AddFormItem(panel.gui.compartmentSelect).
+		AddFormItem(panel.gui.adSelect).
+		AddButton("Next", panel.placementNext).
+		AddButton("Cancel", panel.close)
+
+	panel.gui.ocpusInput.SetAcceptanceFunc(tview.InputFieldFloat)
+	panel.gui.memoryInput.SetAcceptanceFunc(tview.InputFieldFloat)
+	panel.gui.shapeSelect.SetSelectedFunc(func(text string, index int) {
+		panel.fillShapeDefaults(index)
+	})
+	panel.gui.shape.AddFormItem(panel.gui.shapeSelect).
+		AddFormItem(panel.gui.ocpusInput).
+		AddFormItem(panel.gui.memoryInput).
+		AddButton("Back", panel.getShowStepFunc(launchStepPlacement)).
+		AddButton("Next", panel.shapeNext).
+		AddButton("Cancel", panel.close)
+
+	panel.gui.source.AddFormItem(panel.gui.imageSelect).
+		AddFormItem(panel.gui.subnetSelect).
+		AddFormItem(panel.gui.publicIpCheck).
+		AddButton("Back", panel.getShowStepFunc(launchStepShape)).
+		AddButton("Next", panel.sourceNext).
+		AddButton("Cancel", panel.close)
+
+	panel.gui.bootSizeInput.SetAcceptanceFunc(tview.InputFieldInteger)
+	panel.gui.access.AddFormItem(panel.gui.sshKeyInput).
+		AddFormItem(panel.gui.bootSizeInput).
+		AddFormItem(panel.gui.freeTagsInput).
+		AddFormItem(panel.gui.definedTagsInput).
+		AddButton("Back", panel.getShowStepFunc(launchStepSource)).
+		AddButton("Next", panel.accessNext).
+		AddButton("Cancel", panel.close)
+
+	panel.gui.summaryText.SetDynamicColors(true).SetBorder(true)
+	panel.gui.summary.AddButton("Back", panel.getShowStepFunc(launchStepAccess)).
+		AddButton("Launch", panel.launch).
+		AddButton("Close", panel.close)
+	summaryGrid := tview.NewGrid().SetRows(0, 3).SetColumns(0).
+		AddItem(panel.gui.summaryText, 0, 0, 1, 1, 0, 0, false).
+		AddItem(panel.gui.summary, 1, 0, 1, 1, 0, 0, true)
+
+	for _, form := range []*tview.Form{panel.gui.placement, panel.gui.shape, panel.gui.source, panel.gui.access, panel.gui.summary} {
+		form.SetCancelFunc(panel.close)
+	}
+
+	panel.gui.steps.AddPage(launchStepPlacement, panel.gui.placement, true, true)
+	panel.gui.steps.AddPage(launchStepShape, panel.gui.shape, true, false)
+	panel.gui.steps.AddPage(launchStepSource, panel.gui.source, true, false)
+	panel.gui.steps.AddPage(launchStepAccess, panel.gui.access, true, false)
+	panel.gui.steps.AddPage(launchStepSummary, summaryGrid, true, false)
+
+	panel.gui.infoText.SetDynamicColors(true)
+
+	grid := tview.NewGrid()
+	grid.SetColumns(0)
+	grid.SetRows(1, 0)
+	grid.AddItem(panel.gui.infoText, 0, 0, 1, 1, 0, 0, false)
+	grid.AddItem(panel.gui.steps, 1, 0, 1, 1, 0, 0, true)
+	grid.SetBorder(true).SetTitle(fmt.Sprintf("Launch Instance in %s", panel.guiController.GetGUITopPanel().GetSelectedRegionName()))
+
+	panel.gui.mainGrid.SetColumns(0, 110, 0)
+	panel.gui.mainGrid.SetRows(0, 20, 0)
+	panel.gui.mainGrid.AddItem(grid, 1, 1, 1, 1, 0, 0, true)
+
+	panel.fillInitData()
+}
+
+func (panel *InstanceLaunchPanel) fillInitData() {
+	selIdx := 0
+	txt := make([]string, 0)
+	for idx, comp := range panel.compartments {
+		txt = append(txt, *comp.Name)
+		if *comp.Id == panel.compartmentId {
+			selIdx = idx
+		}
+	}
+	panel.gui.compartmentSelect.SetOptions(txt, nil)
+	panel.gui.compartmentSelect.SetCurrentOption(selIdx)
+	panel.gui.bootSizeInput.SetPlaceholder("image default")
+	panel.showStep(launchStepPlacement)
+}
+
+// Loads availability domains, has to be called before panel is shown.
+func (panel *InstanceLaunchPanel) LoadData() error {
+	ads, err := panel.ociController.ListAvailabilityDomains()
+	if err != nil {
+		return err
+	}
+	panel.ads = ads
+	txt := make([]string, 0)
+	for _, ad := range ads {
+		txt = append(txt, *ad.Name)
+	}
+	panel.gui.adSelect.SetOptions(txt, nil)
+	panel.gui.adSelect.SetCurrentOption(0)
+	return nil
+}
+
+func (panel *InstanceLaunchPanel) showStep(step string) {
+	steps := []string{launchStepPlacement, launchStepShape, launchStepSource, launchStepAccess, launchStepSummary}
+	info := make([]string, 0)
+	for _, s := range steps {
+		if s == step {
+			info = append(info, "[green]"+s+"[white]")
+		} else {
+			info = append(info, s)
+		}
+	}
+	panel.gui.infoText.SetText(strings.Join(info, " > "))
+	panel.gui.steps.SwitchToPage(step)
+	switch step {
+	case launchStepPlacement:
+		panel.guiController.SetFocus(panel.gui.placement)
+	case launchStepShape:
+		panel.guiController.SetFocus(panel.gui.shape)
+	case launchStepSource:
+		panel.guiController.SetFocus(panel.gui.source)
+	case launchStepAccess:
+		panel.guiController.SetFocus(panel.gui.access)
+	case launchStepSummary:
+		panel.guiController.SetFocus(panel.gui.summary)
+	}
+}
+
+func (panel *InstanceLaunchPanel) getShowStepFunc(step string) func() {
+	return func() {
+		panel.showStep(step)
+	}
+}
+
+func (panel *InstanceLaunchPanel) showError(message string) {
+	panel.guiController.LogErrorOnPage(message, panel.GetPanelName(), panel.gui.steps)
+}
+
+func (panel *InstanceLaunchPanel) close() {
+	// stops polling of launched instance, panel is not updated afterwards
+	panel.cancel()
+	panel.closeFunc()
+}
+
+func (panel *InstanceLaunchPanel) getSelectedCompartmentId() string {
+	idx, _ := panel.gui.compartmentSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.compartments) {
+		return panel.compartmentId
+	}
+	return *panel.compartments[idx].Id
+}
+
+func (panel *InstanceLaunchPanel) getSelectedAd() string {
+	_, ad := panel.gui.adSelect.GetCurrentOption()
+	return ad
+}
+
+func (panel *InstanceLaunchPanel) getSelectedShape() *core.Shape {
+	idx, _ := panel.gui.shapeSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.shapes) {
+		return nil
+	}
+	return &panel.shapes[idx]
+}
+
+func (panel *InstanceLaunchPanel) getSelectedImage() *core.Image {
+	idx, _ := panel.gui.imageSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.images) {
+		return nil
+	}
+	return &panel.images[idx]
+}
+
+func (panel *InstanceLaunchPanel) getSelectedSubnet() *core.Subnet {
+	idx, _ := panel.gui.subnetSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.subnets) {
+		return nil
+	}
+	return &panel.subnets[idx]
+}
+
+func isFlexShape(shape *core.Shape) bool {
+	return shape != nil && shape.OcpuOptions != nil
+}
+
+func (panel *InstanceLaunchPanel) fillShapeDefaults(index int) {
+	if index < 0 || index >= len(panel.shapes) {
+		return
+	}
+	shape := panel.shapes[index]
+	if isFlexShape(&shape) && shape.Ocpus != nil && shape.MemoryInGBs != nil {
+		panel.gui.ocpusInput.SetText(fmt.Sprintf("%g", *shape.Ocpus))
+		panel.gui.memoryInput.SetText(fmt.Sprintf("%g", *shape.MemoryInGBs))
+	} else {
+		panel.gui.ocpusInput.SetText("")
+		panel.gui.memoryInput.SetText("")
+	}
+}
+
+func (panel *InstanceLaunchPanel) placementNext() {
+	if strings.TrimSpace(panel.gui.nameInput.GetText()) == "" {
+		panel.showError("instance name has to be provided")
+		return
+	}
+	compartmentId := panel.getSelectedCompartmentId()
+	ad := panel.getSelectedAd()
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		shapes, err := panel.ociController.ListShapes(compartmentId, ad)
+		if err != nil {
+			panel.showError(err.Error())
+			return
+		}
+		panel.shapes = shapes
+		txt := make([]string, 0)
+		for _, shape := range shapes {
+			if isFlexShape(&shape) && shape.OcpuOptions.Min != nil && shape.OcpuOptions.Max != nil {
+				txt = append(txt, fmt.Sprintf("%s (flex %g-%g OCPU)", *shape.Shape, *shape.OcpuOptions.Min, *shape.OcpuOptions.Max))
+			} else if shape.Ocpus != nil && shape.MemoryInGBs != nil {
+				txt = append(txt, fmt.Sprintf("%s (%g OCPU, %g GB)", *shape.Shape, *shape.Ocpus, *shape.MemoryInGBs))
+			} else {
+				txt = append(txt, *shape.Shape)
+			}
+		}
+		panel.gui.shapeSelect.SetOptions(txt, nil)
+		panel.gui.shapeSelect.SetCurrentOption(0)
+		panel.showStep(launchStepShape)
+	}()
+}
+
+func (panel *InstanceLaunchPanel) validateShapeConfig() error {
+	shape := panel.getSelectedShape()
+	if shape == nil {
+		return fmt.Errorf("shape has to be selected")
+	}
+	if !isFlexShape(shape) {
+		return nil
+	}
+	ocpus, err := strconv.ParseFloat(panel.gui.ocpusInput.GetText(), 32)
+	if err != nil {
+		return fmt.Errorf("OCPUs has to be provided for flex shape")
+	}
+	if shape.OcpuOptions.Min != nil && shape.OcpuOptions.Max != nil &&
+		(float32(ocpus) < *shape.OcpuOptions.Min || float32(ocpus) > *shape.OcpuOptions.Max) {
+		return fmt.Errorf("OCPUs for %s has to be between %g and %g", *shape.Shape, *shape.OcpuOptions.Min, *shape.OcpuOptions.Max)
+	}
+	memory, err := strconv.ParseFloat(panel.gui.memoryInput.GetText(), 32)
+	if err != nil {
+		return fmt.Errorf("memory has to be provided for flex shape")
+	}
+	if shape.MemoryOptions != nil && shape.MemoryOptions.MinInGBs != nil && shape.MemoryOptions.MaxInGBs != nil {
+		if float32(memory) < *shape.MemoryOptions.MinInGBs || float32(memory) > *shape.MemoryOptions.MaxInGBs {
+			return fmt.Errorf("memory for %s has to be between %g and %g GB", *shape.Shape, *shape.MemoryOptions.MinInGBs, *shape.MemoryOptions.MaxInGBs)
+		}
+	}
+	return nil
+}
+
+func (panel *InstanceLaunchPanel) shapeNext() {
+	if err := panel.validateShapeConfig(); err != nil {
+		panel.showError(err.Error())
+		return
+	}
+	compartmentId := panel.getSelectedCompartmentId()
+	shape := *panel.getSelectedShape().Shape
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		images, err := panel.ociController.ListImages(compartmentId, shape)
+		if err != nil {
+			panel.showError(err.Error())
+			return
+		}
+		subnets, err := panel.ociController.ListSubnets(compartmentId)
+		if err != nil {
+			panel.showError(err.Error())
+			return
+		}
+		panel.images = images
+		panel.subnets = subnets
+		txt := make([]string, 0)
+		for _, image := range images {
+			txt = append(txt, *image.DisplayName)
+		}
+		panel.gui.imageSelect.SetOptions(txt, nil)
+		panel.gui.imageSelect.SetCurrentOption(0)
+		txt = make([]string, 0)
+		for _, subnet := range subnets {
+			txt = append(txt, fmt.Sprintf("%s (%s)", *subnet.DisplayName, *subnet.CidrBlock))
+		}
+		panel.gui.subnetSelect.SetOptions(txt, nil)
+		panel.gui.subnetSelect.SetCurrentOption(0)
+		panel.showStep(launchStepSource)
+	}()
+}
+
+func (panel *InstanceLaunchPanel) sourceNext() {
+	if panel.getSelectedImage() == nil {
+		panel.showError("image has to be selected")
+		return
+	}
+	subnet := panel.getSelectedSubnet()
+	if subnet == nil {
+		panel.showError("subnet has to be selected")
+		return
+	}
+	if panel.gui.publicIpCheck.IsChecked() && subnet.ProhibitPublicIpOnVnic != nil && *subnet.ProhibitPublicIpOnVnic {
+		panel.showError(fmt.Sprintf("subnet %s is private, public IP can not be assigned", *subnet.DisplayName))
+		return
+	}
+	panel.showStep(launchStepAccess)
+}
+
+func (panel *InstanceLaunchPanel) accessNext() {
+	details, err := panel.getLaunchDetails()
+	if err != nil {
+		panel.showError(err.Error())
+		return
+	}
+	panel.gui.summaryText.SetText(panel.describeLaunchDetails(details))
+	panel.showStep(launchStepSummary)
+}
+
+func (panel *InstanceLaunchPanel) getLaunchDetails() (*core.LaunchInstanceDetails, error) {
+	shape := panel.getSelectedShape()
+	image := panel.getSelectedImage()
+	subnet := panel.getSelectedSubnet()
+	if shape == nil || image == nil || subnet == nil {
+		return nil, fmt.Errorf("shape, image and subnet have to be selected")
+	}
+	freeTags, err := parseFreeTags(panel.gui.freeTagsInput.GetText())
+	if err != nil {
+		return nil, err
+	}
+	definedTags, err := parseDefinedTags(panel.gui.definedTagsInput.GetText())
+	if err != nil {
+		return nil, err
+	}
+	source := core.InstanceSourceViaImageDetails{ImageId: image.Id}
+	if txt := panel.gui.bootSizeInput.GetText(); txt != "" {
+		size, err := strconv.ParseInt(txt, 10, 64)
+		if err != nil || size < 50 {
+			return nil, fmt.Errorf("boot volume size has to be at least 50 GB")
+		}
+		source.BootVolumeSizeInGBs = common.Int64(size)
+	}
+	details := core.LaunchInstanceDetails{
+		AvailabilityDomain: common.String(panel.getSelectedAd()),
+		CompartmentId:      common.String(panel.getSelectedCompartmentId()),
+		DisplayName:        common.String(strings.TrimSpace(panel.gui.nameInput.GetText())),
+		Shape:              shape.Shape,
+		SourceDetails:      source,
+		CreateVnicDetails: &core.CreateVnicDetails{
+			SubnetId:       subnet.Id,
+			AssignPublicIp: common.Bool(panel.gui.publicIpCheck.IsChecked()),
+		},
+		FreeformTags: freeTags,
+		DefinedTags:  definedTags,
+	}
+	if key := strings.TrimSpace(panel.gui.sshKeyInput.GetText()); key != "" {
+		details.Metadata = map[string]string{"ssh_authorized_keys": key}
+	}
+	if isFlexShape(shape) {
+		ocpus, _ := strconv.ParseFloat(panel.gui.ocpusInput.GetText(), 32)
+		memory, _ := strconv.ParseFloat(panel.gui.memoryInput.GetText(), 32)
+		details.ShapeConfig = &core.LaunchInstanceShapeConfigDetails{
+			Ocpus:       common.Float32(float32(ocpus)),
+			MemoryInGBs: common.Float32(float32(memory)),
+		}
+	}
+	return &details, nil
+}
+
+func (panel *InstanceLaunchPanel) describeLaunchDetails(details *core.LaunchInstanceDetails) string {
+	var b strings.Builder
+	_, compartment := panel.gui.compartmentSelect.GetCurrentOption()
+	_, shape := panel.gui.shapeSelect.GetCurrentOption()
+	_, image := panel.gui.imageSelect.GetCurrentOption()
+	_, subnet := panel.gui.subnetSelect.GetCurrentOption()
+	fmt.Fprintf(&b, "[yellow]Name:[white] %s\n", *details.DisplayName)
+	fmt.Fprintf(&b, "[yellow]Compartment:[white] %s\n", compartment)
+	fmt.Fprintf(&b, "[yellow]AD:[white] %s\n", *details.AvailabilityDomain)
+	fmt.Fprintf(&b, "[yellow]Shape:[white] %s\n", shape)
+	if details.ShapeConfig != nil {
+		fmt.Fprintf(&b, "[yellow]Shape config:[white] %g OCPU, %g GB\n", *details.ShapeConfig.Ocpus, *details.ShapeConfig.MemoryInGBs)
+	}
+	fmt.Fprintf(&b, "[yellow]Image:[white] %s\n", image)
+	fmt.Fprintf(&b, "[yellow]Subnet:[white] %s public IP: %t\n", subnet, *details.CreateVnicDetails.AssignPublicIp)
+	if source, ok := details.SourceDetails.(core.InstanceSourceViaImageDetails); ok && source.BootVolumeSizeInGBs != nil {
+		fmt.Fprintf(&b, "[yellow]Boot volume:[white] %d GB\n", *source.BootVolumeSizeInGBs)
+	}
+	fmt.Fprintf(&b, "[yellow]SSH key:[white] %t\n", details.Metadata != nil)
+	fmt.Fprintf(&b, "[yellow]Free tags:[white] %d [yellow]Defined tags:[white] %d\n", len(details.FreeformTags), len(details.DefinedTags))
+	return b.String()
+}
+
+func (panel *InstanceLaunchPanel) launch() {
+	if panel.launched != nil {
+		panel.showError("instance was already launched")
+		return
+	}
+	details, err := panel.getLaunchDetails()
+	if err != nil {
+		panel.showError(err.Error())
+		return
+	}
+	summary := panel.gui.summaryText.GetText(false)
+	panel.guiController.SetLoading()
+	go func() {
+		instance, launchErr := panel.ociController.LaunchInstance(*details)
+		panel.guiController.QueueUpdateDraw(func() {
+			panel.guiController.RemoveLoading()
+			if panel.ctx.Err() != nil {
+				return
+			}
+			if launchErr != nil {
+				panel.showError(launchErr.Error())
+				return
+			}
+			panel.launched = instance
+		})
+		if launchErr != nil {
+			return
+		}
+		// follow new instance until it is running or wizard is closed
+		_, err := panel.ociController.WaitForInstanceState(panel.ctx, *instance.Id, core.InstanceLifecycleStateRunning, func(inst *core.Instance) {
+			panel.guiController.QueueUpdateDraw(func() {
+				if panel.ctx.Err() != nil {
+					return
+				}
+				panel.launched = inst
+				panel.gui.summaryText.SetText(fmt.Sprintf("%s\n[yellow]OCID:[white] %s\n[yellow]State:[white] %s", summary, *inst.Id, inst.LifecycleState))
+			})
+		})
+		if err != nil {
+			panel.guiController.QueueUpdateDraw(func() {
+				if panel.ctx.Err() != nil {
+					return
+				}
+				panel.showError(err.Error())
+			})
+		}
+	}()
+}
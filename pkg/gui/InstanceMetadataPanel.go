@@ -0,0 +1,243 @@
+package gui
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/core"
+	"github.com/rivo/tview"
+)
+
+// Panel showing instance metadata: decoded cloud-init user_data,
+// fingerprints of authorized SSH keys and compute agent configuration.
+type InstanceMetadataPanel struct {
+	instance      *core.Instance
+	grid          *tview.Grid
+	userDataText  *tview.TextView
+	sshKeysTable  *tview.Table
+	agentTable    *tview.Table
+	metadataTable *tview.Table
+}
+
+func NewInstanceMetadataPanel(Instance *core.Instance) *InstanceMetadataPanel {
+	res := InstanceMetadataPanel{
+		instance:      Instance,
+		grid:          tview.NewGrid(),
+		userDataText:  tview.NewTextView(),
+		sshKeysTable:  tview.NewTable(),
+		agentTable:    tview.NewTable(),
+		metadataTable: tview.NewTable(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *InstanceMetadataPanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *InstanceMetadataPanel) GetPanelName() string {
+	return "InstanceMetadataPanel"
+}
+
+func (panel *InstanceMetadataPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.userDataText
+}
+
+// Function called when Esc is pressed on any part of the panel.
+func (panel *InstanceMetadataPanel) SetCloseFunc(close func()) {
+	done := func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			close()
+		}
+	}
+	panel.userDataText.SetDoneFunc(done)
+	panel.sshKeysTable.SetDoneFunc(done)
+	panel.agentTable.SetDoneFunc(done)
+	panel.metadataTable.SetDoneFunc(done)
+}
+
+// Sets function switching focus between parts of the panel on Tab.
+func (panel *InstanceMetadataPanel) SetFocusFunc(setFocus func(p tview.Primitive)) {
+	order := []tview.Primitive{panel.userDataText, panel.sshKeysTable, panel.agentTable, panel.metadataTable}
+	for idx, p := range order {
+		next := order[(idx+1)%len(order)]
+		box, ok := p.(interface {
+			SetInputCapture(capture func(event *tcell.EventKey) *tcell.EventKey) *tview.Box
+		})
+		if !ok {
+			continue
+		}
+		box.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if tcell.KeyTab == event.Key() {
+				setFocus(next)
+				return nil
+			}
+			return event
+		})
+	}
+}
+
+func (panel *InstanceMetadataPanel) createGUI() {
+	panel.userDataText.SetBorder(true).SetTitle("Cloud-init user_data")
+	panel.userDataText.SetText(tview.Escape(decodeUserData(panel.instance.Metadata["user_data"])))
+
+	panel.sshKeysTable.SetBorder(true).SetTitle("Authorized SSH keys")
+	panel.sshKeysTable.SetSelectable(true, false)
+	for col, header := range []string{"TYPE", "FINGERPRINT", "COMMENT"} {
+		panel.sshKeysTable.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	keys := strings.Split(strings.TrimSpace(panel.instance.Metadata["ssh_authorized_keys"]), "\n")
+	row := 1
+	for _, key := range keys {
+		key = strings.TrimSpace(key)
+		if key == "" || strings.HasPrefix(key, "#") {
+			continue
+		}
+		keyType, fingerprint, comment := sshKeyFingerprint(key)
+		panel.sshKeysTable.SetCell(row, 0, tview.NewTableCell(keyType))
+		panel.sshKeysTable.SetCell(row, 1, tview.NewTableCell(fingerprint))
+		panel.sshKeysTable.SetCell(row, 2, tview.NewTableCell(tview.Escape(comment)))
+		row += 1
+	}
+	if row == 1 {
+		panel.sshKeysTable.SetCell(row, 0, tview.NewTableCell("No SSH keys.").SetSelectable(false))
+	}
+
+	panel.agentTable.SetBorder(true).SetTitle("Compute agent")
+	panel.agentTable.SetSelectable(true, false)
+	panel.fillAgentTable()
+
+	panel.metadataTable.SetBorder(true).SetTitle("Other metadata")
+	panel.metadataTable.SetSelectable(true, false)
+	panel.fillMetadataTable()
+
+	grid := tview.NewGrid()
+	grid.SetColumns(0, 0)
+	grid.SetRows(0, 8, 8)
+	grid.AddItem(panel.userDataText, 0, 0, 1, 2, 0, 0, true)
+	grid.AddItem(panel.sshKeysTable, 1, 0, 1, 2, 0, 0, false)
+	grid.AddItem(panel.agentTable, 2, 0, 1, 1, 0, 0, false)
+	grid.AddItem(panel.metadataTable, 2, 1, 1, 1, 0, 0, false)
+	grid.SetBorder(true).SetTitle("Instance Metadata of " + *panel.instance.DisplayName)
+
+	panel.grid.SetColumns(0, 120, 0)
+	panel.grid.SetRows(0, 36, 0)
+	panel.grid.AddItem(grid, 1, 1, 1, 1, 0, 0, true)
+}
+
+func (panel *InstanceMetadataPanel) fillAgentTable() {
+	table := panel.agentTable
+	table.SetCell(0, 0, tview.NewTableCell("PLUGIN").SetAlign(tview.AlignCenter).SetSelectable(false))
+	table.SetCell(0, 1, tview.NewTableCell("STATE").SetAlign(tview.AlignCenter).SetSelectable(false))
+	config := panel.instance.AgentConfig
+	if config == nil {
+		table.SetCell(1, 0, tview.NewTableCell("No agent configuration.").SetSelectable(false))
+		return
+	}
+	disabledToString := func(disabled *bool) (string, tcell.Color) {
+		if disabled != nil && *disabled {
+			return "DISABLED", tcell.ColorYellow
+		}
+		return "ENABLED", tcell.ColorGreen
+	}
+	row := 1
+	for _, item := range []struct {
+		name     string
+		disabled *bool
+	}{
+		{"Monitoring", config.IsMonitoringDisabled},
+		{"Management", config.IsManagementDisabled},
+		{"All plugins", config.AreAllPluginsDisabled},
+	} {
+		state, color := disabledToString(item.disabled)
+		table.SetCell(row, 0, tview.NewTableCell(item.name))
+		table.SetCell(row, 1, tview.NewTableCell(state).SetTextColor(color))
+		row += 1
+	}
+	for _, plugin := range config.PluginsConfig {
+		color := tcell.ColorGreen
+		if plugin.DesiredState != core.InstanceAgentPluginConfigDetailsDesiredStateEnabled {
+			color = tcell.ColorYellow
+		}
+		table.SetCell(row, 0, tview.NewTableCell(oci.StringOrEmpty(plugin.Name)))
+		table.SetCell(row, 1, tview.NewTableCell(string(plugin.DesiredState)).SetTextColor(color))
+		row += 1
+	}
+}
+
+func (panel *InstanceMetadataPanel) fillMetadataTable() {
+	table := panel.metadataTable
+	table.SetCell(0, 0, tview.NewTableCell("KEY").SetAlign(tview.AlignCenter).SetSelectable(false))
+	table.SetCell(0, 1, tview.NewTableCell("VALUE").SetAlign(tview.AlignCenter).SetSelectable(false))
+	keys := make([]string, 0)
+	for k := range panel.instance.Metadata {
+		if k != "user_data" && k != "ssh_authorized_keys" {
+			keys = append(keys, k)
+		}
+	}
+	for k := range panel.instance.ExtendedMetadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for row, k := range keys {
+		row += 1
+		value, ok := panel.instance.Metadata[k]
+		if !ok {
+			value = fmt.Sprintf("%v", panel.instance.ExtendedMetadata[k])
+		}
+		table.SetCell(row, 0, tview.NewTableCell(tview.Escape(k)))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(value)))
+	}
+	if len(keys) == 0 {
+		table.SetCell(1, 0, tview.NewTableCell("No other metadata.").SetSelectable(false))
+	}
+}
+
+// Decodes base64 encoded user_data, gzip compressed content is decompressed.
+func decodeUserData(encoded string) string {
+	if encoded == "" {
+		return "No user_data provided."
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return fmt.Sprintf("user_data is not valid base64: %s", err.Error())
+	}
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		reader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Sprintf("user_data is not valid gzip: %s", err.Error())
+		}
+		defer reader.Close()
+		unpacked, err := io.ReadAll(reader)
+		if err != nil {
+			return fmt.Sprintf("user_data is not valid gzip: %s", err.Error())
+		}
+		data = unpacked
+	}
+	return string(data)
+}
+
+// Returns type, SHA256 fingerprint (same format as ssh-keygen -l) and comment of authorized key line.
+func sshKeyFingerprint(key string) (keyType string, fingerprint string, comment string) {
+	fields := strings.Fields(key)
+	if len(fields) < 2 {
+		return "", "invalid key", key
+	}
+	keyType = fields[0]
+	comment = strings.Join(fields[2:], " ")
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return keyType, "invalid key", comment
+	}
+	sum := sha256.Sum256(blob)
+	return keyType, "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), comment
+}
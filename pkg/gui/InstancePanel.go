@@ -1,6 +1,7 @@
 package gui
 
 import (
+	"fmt"
 	"log"
 	"sort"
 	"strconv"
@@ -43,6 +44,8 @@ type InstancesPanel struct {
 	sortBy             map[string]core.ListInstancesSortByEnum
 	sortOrder          map[string]core.ListInstancesSortOrderEnum
 	lifecycleState     map[string]core.InstanceLifecycleStateEnum
+	// open monitoring panel, its live refresh is stopped when panel is removed
+	monitoringPanel *InstanceMonitoringPanel
 }
 
 func NewInstancesPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *InstancesPanel {
@@ -259,47 +262,49 @@ func (panel *InstancesPanel) makeKeyBindings() {
 		}()
 
 	})
-	panel.gui.refreshButton.SetSelectedFunc(func() {
-		panel.guiController.SetLoading()
-
-		go func() {
-			panel.instancesPagesLock.Lock()
-			defer func() {
-				panel.instancesPagesLock.Unlock()
-				panel.guiController.RemoveLoading()
-				panel.guiController.SetFocus(panel.gui.mainTable)
-				panel.guiController.RefreshGUI()
-			}()
-			// if data already exists refresh
-			if panel.currentPageIdx > -1 {
-				panel.currentPageIdx = -1
-				panel.instancesPages = make([]instancesPage, 0)
-				panel.gui.mainTable.Clear()
-			}
-			instances, nextPage, err := panel.ociController.ListInstances(
-				panel.compartmentId,
-				panel.getCurrnetLimit(),
-				panel.getCurrentSortBy(),
-				panel.getCurrentSortOrder(),
-				panel.getCurrentLifecycleState(),
-				"",
-			)
-			if err != nil {
-				log.Print(err.Error())
-				return
-			}
-			p := ""
-			panel.instancesPages = append(panel.instancesPages, instancesPage{
-				page:      &p,
-				instances: &instances,
-				nextPage:  &nextPage,
-			})
-			panel.currentPageIdx = 0
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+}
 
-			panel.refreshTable()
+// Reloads first page of instances from OCI.
+func (panel *InstancesPanel) reload() {
+	panel.guiController.SetLoading()
+
+	go func() {
+		panel.instancesPagesLock.Lock()
+		defer func() {
+			panel.instancesPagesLock.Unlock()
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
 		}()
+		// if data already exists refresh
+		if panel.currentPageIdx > -1 {
+			panel.currentPageIdx = -1
+			panel.instancesPages = make([]instancesPage, 0)
+			panel.gui.mainTable.Clear()
+		}
+		instances, nextPage, err := panel.ociController.ListInstances(
+			panel.compartmentId,
+			panel.getCurrnetLimit(),
+			panel.getCurrentSortBy(),
+			panel.getCurrentSortOrder(),
+			panel.getCurrentLifecycleState(),
+			"",
+		)
+		if err != nil {
+			log.Print(err.Error())
+			return
+		}
+		p := ""
+		panel.instancesPages = append(panel.instancesPages, instancesPage{
+			page:      &p,
+			instances: &instances,
+			nextPage:  &nextPage,
+		})
+		panel.currentPageIdx = 0
 
-	})
+		panel.refreshTable()
+	}()
 }
 
 func (panel *InstancesPanel) refreshTable() {
@@ -344,58 +349,18 @@ func (panel *InstancesPanel) refreshTable() {
 			row, _ := panel.gui.mainTable.GetSelection()
 			instances := *(panel.instancesPages[panel.currentPageIdx].instances)
 			instance := instances[row-1]
-			detail := NewInstanceActionPanel(&instance)
-			panel.guiController.SetFocus(detail.actionSelect)
-			detail.actionSelect.SetDoneFunc(func(key tcell.Key) {
-				if tcell.KeyTab == key {
-					panel.guiController.SetFocus(detail.executeButton)
-				}
-				if tcell.KeyEscape == key {
-					panel.guiController.RemovePage(detail.GetPanelName(), n_main)
-					panel.guiController.SetFocus(panel.gui.mainTable)
-				}
-			})
-			detail.executeButton.SetExitFunc(func(key tcell.Key) {
-				if tcell.KeyTab == key {
-					panel.guiController.SetFocus(detail.actionSelect)
-				}
-				if tcell.KeyEscape == key {
-					panel.guiController.RemovePage(detail.GetPanelName(), n_main)
-					panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.SetLoading()
+			go func() {
+				// credentials action is offered only for Windows instances
+				windows, err := panel.ociController.IsWindowsInstance(&instance)
+				if err != nil {
+					log.Print("ERROR " + err.Error())
 				}
-			})
-			// Modal window to confirm instance action
-			modalName := "ModalInstanceActionPanel"
-			detail.executeButton.SetSelectedFunc(func() {
-
-				modal := tview.NewModal().
-					SetText("Do you want to execute action?").
-					AddButtons([]string{"Execute", "Cancel"}).
-					SetDoneFunc(func(buttonIndex int, buttonLabel string) {
-						defer func() {
-							panel.guiController.RemovePage(modalName, detail.GetPanelName())
-							panel.guiController.RemovePage(detail.GetPanelName(), n_main)
-							panel.guiController.SetFocus(panel.gui.mainTable)
-						}()
-
-						if buttonLabel == "Execute" {
-							func() {
-								panel.guiController.SetLoading()
-								defer panel.guiController.RemoveLoading()
-								ocid := detail.GetInstanceOCID()
-								action := detail.GetSelectedAction()
-								newInstance, err := panel.ociController.ExecuteInstanceAction(&ocid, action)
-								if err != nil {
-									log.Print("ERROR " + err.Error())
-									return
-								}
-								panel.refreshInstance(newInstance)
-							}()
-						}
-					})
-				panel.guiController.AddPage(modalName, modal, false)
-			})
-			panel.guiController.AddPage(detail.GetPanelName(), detail.GetGUI(), true)
+				panel.guiController.QueueUpdateDraw(func() {
+					panel.guiController.RemoveLoading()
+					panel.showActionPanel(instance, windows)
+				})
+			}()
 		}
 		// r for refresh
 		if tcell.KeyRune == key && event.Rune() == 'r' {
@@ -411,51 +376,56 @@ func (panel *InstancesPanel) refreshTable() {
 			instances := *(panel.instancesPages[panel.currentPageIdx].instances)
 			instance := instances[row-1]
 			monitoringPanel := NewInstanceMonitoringPanel(panel.guiController, panel.ociController, &instance, panel.compartmentId)
+			panel.stopMonitoring()
+			panel.monitoringPanel = monitoringPanel
 			panel.guiController.SetFocus(monitoringPanel.gui.exitButton)
 			close := func() {
+				panel.stopMonitoring()
 				panel.guiController.RemovePage(monitoringPanel.GetPanelName(), n_main)
 				panel.guiController.SetFocus(panel.gui.mainTable)
 			}
 			monitoringPanel.gui.exitButton.SetExitFunc(func(key tcell.Key) {
-				if tcell.KeyEscape == key {
+				switch key {
+				case tcell.KeyEscape:
 					close()
+				case tcell.KeyTab:
+					panel.guiController.SetFocus(monitoringPanel.gui.viewSelect)
+				case tcell.KeyBacktab:
+					panel.guiController.SetFocus(monitoringPanel.gui.applyButton)
 				}
 			})
 			monitoringPanel.gui.exitButton.SetSelectedFunc(close)
 			// monitoringPanel.gui.exitButton.SetFocusFunc()
 			panel.guiController.AddPage(monitoringPanel.GetPanelName(), monitoringPanel.GetGUI(), true)
 
-			monitoringPanel.LoadData()
+			monitoringPanel.reload()
 			// TODO
 		}
+		// e for edit
+		if tcell.KeyRune == key && event.Rune() == 'e' {
+			row, _ := panel.gui.mainTable.GetSelection()
+			instances := *(panel.instancesPages[panel.currentPageIdx].instances)
+			instance := instances[row-1]
+			panel.showEditPanel(&instance)
+		}
+		// v for moving instance to other compartment
+		if tcell.KeyRune == key && event.Rune() == 'v' {
+			row, _ := panel.gui.mainTable.GetSelection()
+			instances := *(panel.instancesPages[panel.currentPageIdx].instances)
+			instance := instances[row-1]
+			panel.showMovePanel(&instance)
+		}
+		// n for new instance
+		if tcell.KeyRune == key && event.Rune() == 'n' {
+			panel.showLaunchPanel()
+		}
 		return event
 	})
 	// open instace detail window
 	panel.gui.mainTable.SetSelectedFunc(func(row, column int) {
-		panelName := "InstanceDetailPanel"
 		instances := *(panel.instancesPages[panel.currentPageIdx].instances)
 		instance := instances[row-1]
-		detail := NewInstanceDetailPanel(&instance)
-		panel.guiController.SetFocus(detail.freeTagTable)
-		detail.freeTagTable.SetDoneFunc(func(key tcell.Key) {
-			if tcell.KeyTab == key {
-				panel.guiController.SetFocus(detail.definedTagTable)
-			}
-			if tcell.KeyEscape == key {
-				panel.guiController.RemovePage(panelName, n_main)
-				panel.guiController.SetFocus(panel.gui.mainTable)
-			}
-		})
-		detail.definedTagTable.SetDoneFunc(func(key tcell.Key) {
-			if tcell.KeyTab == key {
-				panel.guiController.SetFocus(detail.freeTagTable)
-			}
-			if tcell.KeyEscape == key {
-				panel.guiController.RemovePage(panelName, n_main)
-				panel.guiController.SetFocus(panel.gui.mainTable)
-			}
-		})
-		panel.guiController.AddPage(panelName, detail.GetGUI(), true)
+		panel.showDetailPanel(&instance)
 	})
 }
 
@@ -519,13 +489,362 @@ func (panel *InstancesPanel) Show(pages *tview.Pages) {
 }
 
 func (panel *InstancesPanel) Remove(pages *tview.Pages) {
+	panel.stopMonitoring()
 	if pages.HasPage(panel.GetPanelName()) {
 		pages.RemovePage(panel.GetPanelName())
 	}
 }
 
+// Stops live refresh of open monitoring panel.
+func (panel *InstancesPanel) stopMonitoring() {
+	if panel.monitoringPanel != nil {
+		panel.monitoringPanel.Stop()
+		panel.monitoringPanel = nil
+	}
+}
+
 func (panel *InstancesPanel) GetInfo() string {
-	return "[red]Enter:[white] Details [red]Esc:[white] Exit [green]a:[white] Action [green]r:[white] Refresh [green]m:[white] Monitoring"
+	return "[red]Enter:[white] Details [red]Esc:[white] Exit [green]a:[white] Action [green]r:[white] Refresh [green]m:[white] Monitoring [green]n:[white] New [green]e:[white] Edit [green]v:[white] Move"
+}
+
+func (panel *InstancesPanel) showDetailPanel(instance *core.Instance) {
+	panelName := "InstanceDetailPanel"
+	detail := NewInstanceDetailPanel(instance)
+	panel.guiController.SetFocus(detail.freeTagTable)
+	NewTagEditor(panel.guiController, panel.ociController, panelName, detail.freeTagTable, detail.definedTagTable,
+		instance.FreeformTags, instance.DefinedTags,
+		func(freeTags map[string]string, definedTags map[string]map[string]interface{}) error {
+			_, err := panel.ociController.UpdateInstance(*instance.Id, core.UpdateInstanceDetails{
+				FreeformTags: freeTags,
+				DefinedTags:  definedTags,
+			})
+			if err != nil {
+				return err
+			}
+			go panel.RefreshOciIntance(*instance.Id)
+			return nil
+		})
+	close := func() {
+		panel.guiController.RemovePage(panelName, n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+	}
+	detail.freeTagTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(detail.definedTagTable)
+		}
+		if tcell.KeyEscape == key {
+			close()
+		}
+	})
+	detail.definedTagTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(detail.networkTable)
+		}
+		if tcell.KeyEscape == key {
+			close()
+		}
+	})
+	detail.networkTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(detail.storageTable)
+		}
+		if tcell.KeyEscape == key {
+			close()
+		}
+	})
+	detail.storageTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(detail.freeTagTable)
+		}
+		if tcell.KeyEscape == key {
+			close()
+		}
+	})
+	loadStorage := func() {
+		defer panel.guiController.RefreshGUI()
+		volumes, err := panel.ociController.ListInstanceStorage(*instance.CompartmentId, *instance.AvailabilityDomain, *instance.Id)
+		if err != nil {
+			detail.SetStorageError(err.Error())
+			return
+		}
+		detail.SetStorage(volumes)
+	}
+	detail.storageTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// a for attaching volume
+		if tcell.KeyRune == event.Key() && event.Rune() == 'a' {
+			panel.showVolumeAttachPanel(instance, panelName, detail.storageTable, func() { go loadStorage() })
+			return nil
+		}
+		// d for detaching selected volume
+		if tcell.KeyRune == event.Key() && event.Rune() == 'd' {
+			volume := detail.GetSelectedVolume()
+			if volume == nil {
+				return nil
+			}
+			if volume.IsBoot {
+				panel.guiController.LogErrorOnPage("boot volume can not be detached, stop instance and use console instead", panelName, detail.storageTable)
+				return nil
+			}
+			panel.showVolumeDetachModal(*volume, panelName, detail.storageTable, func() { go loadStorage() })
+			return nil
+		}
+		return event
+	})
+	detail.grid.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// u for metadata and cloud-init user_data
+		if tcell.KeyRune == event.Key() && event.Rune() == 'u' {
+			panel.showMetadataPanel(instance, panelName, panel.guiController.GetFocus())
+			return nil
+		}
+		return event
+	})
+	panel.guiController.AddPage(panelName, detail.GetGUI(), true)
+	go func() {
+		defer panel.guiController.RefreshGUI()
+		vnics, err := panel.ociController.ListInstanceNetworking(*instance.CompartmentId, *instance.Id)
+		if err != nil {
+			detail.SetNetworkingError(err.Error())
+			return
+		}
+		detail.SetNetworking(vnics)
+	}()
+	go loadStorage()
+}
+
+func (panel *InstancesPanel) showMetadataPanel(instance *core.Instance, returnPage string, returnFocus tview.Primitive) {
+	metadataPanel := NewInstanceMetadataPanel(instance)
+	metadataPanel.SetCloseFunc(func() {
+		panel.guiController.RemovePage(metadataPanel.GetPanelName(), returnPage)
+		panel.guiController.SetFocus(returnFocus)
+	})
+	metadataPanel.SetFocusFunc(panel.guiController.SetFocus)
+	panel.guiController.AddPage(metadataPanel.GetPanelName(), metadataPanel.GetGUI(), true)
+	panel.guiController.SetFocus(metadataPanel.GetFocusPrimitive())
+}
+
+func (panel *InstancesPanel) showVolumeAttachPanel(instance *core.Instance, returnPage string, returnFocus tview.Primitive, onAttach func()) {
+	attachPanel := NewVolumeAttachPanel(panel.guiController, panel.ociController, instance)
+	attachPanel.SetCloseFunc(func(attached bool) {
+		panel.guiController.RemovePage(attachPanel.GetPanelName(), returnPage)
+		panel.guiController.SetFocus(returnFocus)
+		if attached {
+			onAttach()
+		}
+	})
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if err := attachPanel.LoadData(); err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), returnPage, returnFocus)
+			return
+		}
+		panel.guiController.AddPage(attachPanel.GetPanelName(), attachPanel.GetGUI(), true)
+		panel.guiController.SetFocus(attachPanel.GetFocusPrimitive())
+	}()
+}
+
+func (panel *InstancesPanel) showVolumeDetachModal(volume oci.InstanceVolume, returnPage string, returnFocus tview.Primitive, onDetach func()) {
+	modalName := "ModalVolumeDetach"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to detach volume %s?\nMake sure it is unmounted in the operating system.", volume.Name)).
+		AddButtons([]string{"Detach", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, returnPage)
+			panel.guiController.SetFocus(returnFocus)
+			if buttonLabel != "Detach" {
+				return
+			}
+			go func() {
+				if err := panel.ociController.DetachVolume(volume.AttachmentId); err != nil {
+					panel.guiController.LogErrorOnPage(err.Error(), returnPage, returnFocus)
+					panel.guiController.RefreshGUI()
+					return
+				}
+				onDetach()
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
+
+func (panel *InstancesPanel) showActionPanel(instance core.Instance, windows bool) {
+	detail := NewInstanceActionPanel(&instance, windows)
+	panel.guiController.SetFocus(detail.actionSelect)
+	detail.actionSelect.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(detail.executeButton)
+		}
+		if tcell.KeyEscape == key {
+			panel.guiController.RemovePage(detail.GetPanelName(), n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+		}
+	})
+	detail.executeButton.SetExitFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(detail.actionSelect)
+		}
+		if tcell.KeyEscape == key {
+			panel.guiController.RemovePage(detail.GetPanelName(), n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+		}
+	})
+	// Modal window to confirm instance action
+	modalName := "ModalInstanceActionPanel"
+	detail.executeButton.SetSelectedFunc(func() {
+		if detail.IsTerminateSelected() {
+			panel.guiController.RemovePage(detail.GetPanelName(), n_main)
+			panel.showTerminatePanel(&instance)
+			return
+		}
+		if detail.IsWindowsCredentialsSelected() {
+			panel.guiController.RemovePage(detail.GetPanelName(), n_main)
+			panel.showCredentialsPanel(&instance)
+			return
+		}
+
+		modal := tview.NewModal().
+			SetText("Do you want to execute action?").
+			AddButtons([]string{"Execute", "Cancel"}).
+			SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+				defer func() {
+					panel.guiController.RemovePage(modalName, detail.GetPanelName())
+					panel.guiController.RemovePage(detail.GetPanelName(), n_main)
+					panel.guiController.SetFocus(panel.gui.mainTable)
+				}()
+
+				if buttonLabel == "Execute" {
+					func() {
+						panel.guiController.SetLoading()
+						defer panel.guiController.RemoveLoading()
+						ocid := detail.GetInstanceOCID()
+						action := detail.GetSelectedAction()
+						newInstance, err := panel.ociController.ExecuteInstanceAction(&ocid, action)
+						if err != nil {
+							log.Print("ERROR " + err.Error())
+							return
+						}
+						panel.refreshInstance(newInstance)
+					}()
+				}
+			})
+		panel.guiController.AddPage(modalName, modal, false)
+	})
+	panel.guiController.AddPage(detail.GetPanelName(), detail.GetGUI(), true)
+}
+
+func (panel *InstancesPanel) showCredentialsPanel(instance *core.Instance) {
+	credentialsPanel := NewInstanceCredentialsPanel(panel.guiController, panel.ociController, instance)
+	credentialsPanel.SetCloseFunc(func() {
+		panel.guiController.RemovePage(credentialsPanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+	})
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if err := credentialsPanel.LoadData(); err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.guiController.AddPage(credentialsPanel.GetPanelName(), credentialsPanel.GetGUI(), true)
+		panel.guiController.SetFocus(credentialsPanel.GetFocusPrimitive())
+	}()
+}
+
+func (panel *InstancesPanel) showTerminatePanel(instance *core.Instance) {
+	terminatePanel := NewInstanceTerminatePanel(panel.guiController, panel.ociController, instance)
+	terminatePanel.SetCloseFunc(func(terminated bool) {
+		panel.guiController.RemovePage(terminatePanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if terminated {
+			go panel.RefreshOciIntance(*instance.Id)
+		}
+	})
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if err := terminatePanel.LoadData(); err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.guiController.AddPage(terminatePanel.GetPanelName(), terminatePanel.GetGUI(), true)
+		panel.guiController.SetFocus(terminatePanel.GetFocusPrimitive())
+	}()
+}
+
+func (panel *InstancesPanel) showEditPanel(instance *core.Instance) {
+	editPanel := NewInstanceEditPanel(panel.guiController, panel.ociController, instance)
+	editPanel.SetCloseFunc(func(updated *core.Instance) {
+		panel.guiController.RemovePage(editPanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if updated != nil {
+			panel.refreshInstance(updated)
+		}
+	})
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if err := editPanel.LoadData(); err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.guiController.AddPage(editPanel.GetPanelName(), editPanel.GetGUI(), true)
+		panel.guiController.SetFocus(editPanel.GetFocusPrimitive())
+	}()
+}
+
+func (panel *InstancesPanel) showMovePanel(instance *core.Instance) {
+	movePanel := NewMoveResourcePanel(panel.guiController, panel.ociController, panel.tenancyId, oci.ResourceSummary{
+		Type:           oci.ResourceInstance,
+		Id:             *instance.Id,
+		Name:           *instance.DisplayName,
+		CompartmentId:  *instance.CompartmentId,
+		LifecycleState: string(instance.LifecycleState),
+		TimeCreated:    instance.TimeCreated.Time,
+	})
+	movePanel.SetCloseFunc(func(moved bool) {
+		panel.guiController.RemovePage(movePanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if moved {
+			panel.reload()
+		}
+	})
+	panel.guiController.AddPage(movePanel.GetPanelName(), movePanel.GetGUI(), true)
+	panel.guiController.SetFocus(movePanel.GetFocusPrimitive())
+}
+
+func (panel *InstancesPanel) showLaunchPanel() {
+	launchPanel := NewInstanceLaunchPanel(panel.guiController, panel.ociController, panel.compartmentId)
+	launchPanel.SetCloseFunc(func() {
+		panel.guiController.RemovePage(launchPanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if launchPanel.GetLaunchedInstance() != nil {
+			panel.reload()
+		}
+	})
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if err := launchPanel.LoadData(); err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.guiController.AddPage(launchPanel.GetPanelName(), launchPanel.GetGUI(), true)
+		panel.guiController.SetFocus(launchPanel.GetFocusPrimitive())
+	}()
 }
 
 func (panel *InstancesPanel) RefreshOciIntance(OcidId string) {
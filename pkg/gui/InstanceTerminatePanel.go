@@ -0,0 +1,148 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/core"
+	"github.com/rivo/tview"
+)
+
+// Panel terminating compute instance.
+// Shows resources affected by termination and requires typing instance name to confirm.
+type InstanceTerminatePanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	instance      *core.Instance
+	grid          *tview.Grid
+	summaryText   *tview.TextView
+	form          *tview.Form
+	preserveCheck *tview.Checkbox
+	confirmInput  *tview.InputField
+	closeFunc     func(terminated bool)
+}
+
+func NewInstanceTerminatePanel(GuiController *GuiController, OciController *oci.OCIController, Instance *core.Instance) *InstanceTerminatePanel {
+	res := InstanceTerminatePanel{
+		guiController: GuiController,
+		ociController: OciController,
+		instance:      Instance,
+		grid:          tview.NewGrid(),
+		summaryText:   tview.NewTextView(),
+		form:          tview.NewForm(),
+		preserveCheck: tview.NewCheckbox().SetLabel("Preserve boot volume:").SetChecked(true),
+		confirmInput:  tview.NewInputField().SetLabel("Type instance name to confirm:").SetFieldWidth(40),
+		closeFunc:     func(terminated bool) {},
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *InstanceTerminatePanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *InstanceTerminatePanel) GetPanelName() string {
+	return "InstanceTerminatePanel"
+}
+
+func (panel *InstanceTerminatePanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+// Function called when panel is closed, terminated is true if termination was requested.
+func (panel *InstanceTerminatePanel) SetCloseFunc(close func(terminated bool)) {
+	panel.closeFunc = close
+}
+
+func (panel *InstanceTerminatePanel) createGUI() {
+	panel.summaryText.SetDynamicColors(true).SetBorder(true).SetTitle("Affected resources")
+	panel.form.AddFormItem(panel.preserveCheck).
+		AddFormItem(panel.confirmInput).
+		AddButton("Terminate", panel.terminate).
+		AddButton("Cancel", func() { panel.closeFunc(false) })
+	panel.form.SetCancelFunc(func() { panel.closeFunc(false) })
+
+	grid := tview.NewGrid()
+	grid.SetColumns(0)
+	grid.SetRows(0, 7)
+	grid.AddItem(panel.summaryText, 0, 0, 1, 1, 0, 0, false)
+	grid.AddItem(panel.form, 1, 0, 1, 1, 0, 0, true)
+	grid.SetBorder(true).SetTitle("Terminate Instance")
+
+	panel.grid.SetColumns(0, 100, 0)
+	panel.grid.SetRows(0, 25, 0)
+	panel.grid.AddItem(grid, 1, 1, 1, 1, 0, 0, true)
+}
+
+// Loads attached volumes and public IPs of the instance.
+func (panel *InstanceTerminatePanel) LoadData() error {
+	compartmentId := *panel.instance.CompartmentId
+	bootAttachments, err := panel.ociController.ListBootVolumeAttachments(compartmentId, *panel.instance.AvailabilityDomain, *panel.instance.Id)
+	if err != nil {
+		return err
+	}
+	volumeAttachments, err := panel.ociController.ListVolumeAttachments(compartmentId, *panel.instance.Id)
+	if err != nil {
+		return err
+	}
+	vnics, err := panel.ociController.ListInstanceVnics(compartmentId, *panel.instance.Id)
+	if err != nil {
+		return err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[yellow]Name:[white] %s\n", *panel.instance.DisplayName)
+	fmt.Fprintf(&b, "[yellow]OCID:[white] %s\n", *panel.instance.Id)
+	fmt.Fprintf(&b, "[yellow]Lifecycle:[white] %s\n\n", panel.instance.LifecycleState)
+	fmt.Fprintf(&b, "[yellow]Boot volume[white] (deleted unless preserved):\n")
+	for _, att := range bootAttachments {
+		fmt.Fprintf(&b, "  %s\n", *att.BootVolumeId)
+	}
+	fmt.Fprintf(&b, "[yellow]Block volumes[white] (detached, not deleted):\n")
+	attached := 0
+	for _, att := range volumeAttachments {
+		if att.GetLifecycleState() == core.VolumeAttachmentLifecycleStateDetached {
+			continue
+		}
+		fmt.Fprintf(&b, "  %s %s\n", oci.StringOrEmpty(att.GetDisplayName()), *att.GetVolumeId())
+		attached++
+	}
+	if attached == 0 {
+		fmt.Fprintf(&b, "  none\n")
+	}
+	fmt.Fprintf(&b, "[yellow]Public IPs[white] (ephemeral ones are released):\n")
+	publicIps := 0
+	for _, vnic := range vnics {
+		if vnic.PublicIp != nil {
+			fmt.Fprintf(&b, "  %s on %s (private %s)\n", *vnic.PublicIp, oci.StringOrEmpty(vnic.DisplayName), oci.StringOrEmpty(vnic.PrivateIp))
+			publicIps++
+		}
+	}
+	if publicIps == 0 {
+		fmt.Fprintf(&b, "  none\n")
+	}
+	panel.summaryText.SetText(b.String())
+	return nil
+}
+
+func (panel *InstanceTerminatePanel) terminate() {
+	if panel.confirmInput.GetText() != *panel.instance.DisplayName {
+		panel.guiController.LogErrorOnPage("typed name does not match instance name", panel.GetPanelName(), panel.form)
+		return
+	}
+	preserve := panel.preserveCheck.IsChecked()
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if err := panel.ociController.TerminateInstance(*panel.instance.Id, preserve); err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+			return
+		}
+		panel.closeFunc(true)
+	}()
+}
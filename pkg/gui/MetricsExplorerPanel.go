@@ -0,0 +1,478 @@
+package gui
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/jszczuko/plot4tview/pkg/gui"
+	"github.com/rivo/tview"
+)
+
+// Time range of metric explorer with default interval of query and format of time axis.
+type metricRange struct {
+	name       string
+	duration   time.Duration
+	interval   string
+	timeFormat string
+}
+
+var metricExplorerRanges = []metricRange{
+	{"1 h", time.Hour, "1m", "15:04"},
+	{"6 h", 6 * time.Hour, "1m", "15:04"},
+	{"24 h", 24 * time.Hour, "5m", "15:04"},
+	{"7 d", 7 * 24 * time.Hour, "1h", "01/02 15h"},
+	{"30 d", 30 * 24 * time.Hour, "1h", "01/02"},
+}
+
+// Colors of plotted series, series over the palette reuse colors from its beginning.
+var seriesColors = []tcell.Color{
+	tcell.ColorGreen,
+	tcell.ColorYellow,
+	tcell.ColorLightBlue,
+	tcell.ColorRed,
+	tcell.ColorPurple,
+	tcell.ColorOrange,
+	tcell.ColorWhite,
+	tcell.ColorAqua,
+}
+
+type metricsExplorerGUI struct {
+	mainGrid        *tview.Grid
+	namespaceSelect *tview.DropDown
+	refreshButton   *tview.Button
+	queryInput      *tview.InputField
+	rangeSelect     *tview.DropDown
+	runButton       *tview.Button
+	metricsTable    *tview.Table
+	dimensionsTable *tview.Table
+	plot            *gui.DotPlot
+	legendTable     *tview.Table
+}
+
+// Panel exploring metrics of the compartment: namespaces, metrics and their dimensions.
+// Any MQL query can be run, every returned metric stream is plotted in its own color.
+type MetricsExplorerPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *metricsExplorerGUI
+	namespaces    []string
+	metricNames   []string
+	dimensions    [][2]string
+	tenancyId     string
+	compartmentId string
+}
+
+func NewMetricsExplorerPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *MetricsExplorerPanel {
+	res := MetricsExplorerPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newMetricsExplorerGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewMetricsExplorerAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewMetricsExplorerPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newMetricsExplorerGUI() *metricsExplorerGUI {
+	res := metricsExplorerGUI{
+		mainGrid:        tview.NewGrid(),
+		namespaceSelect: tview.NewDropDown(),
+		refreshButton:   tview.NewButton("Refresh"),
+		queryInput:      tview.NewInputField(),
+		rangeSelect:     tview.NewDropDown(),
+		runButton:       tview.NewButton("Run"),
+		metricsTable:    tview.NewTable(),
+		dimensionsTable: tview.NewTable(),
+		legendTable:     tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *MetricsExplorerPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 45, 20, 70, 20, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 16, 16, 8, 0)
+
+	panel.gui.namespaceSelect.SetBorder(true).SetTitle("Namespace")
+	panel.gui.mainGrid.AddItem(panel.gui.namespaceSelect, 1, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 2, 1, 1, 0, 0, false)
+	panel.gui.queryInput.SetPlaceholder("CpuUtilization[1m].mean()").
+		SetBorder(true).SetTitle("MQL Query")
+	panel.gui.mainGrid.AddItem(panel.gui.queryInput, 1, 3, 1, 1, 0, 0, false)
+	var ranges []string
+	for _, r := range metricExplorerRanges {
+		ranges = append(ranges, r.name)
+	}
+	panel.gui.rangeSelect.SetOptions(ranges, nil).SetCurrentOption(2)
+	panel.gui.rangeSelect.SetBorder(true).SetTitle("Last")
+	panel.gui.mainGrid.AddItem(panel.gui.rangeSelect, 1, 4, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.runButton), 1, 5, 1, 1, 0, 0, false)
+
+	panel.gui.metricsTable.SetBorder(true).SetTitle("Metrics")
+	panel.gui.mainGrid.AddItem(panel.gui.metricsTable, 2, 1, 1, 2, 0, 0, false)
+	panel.gui.dimensionsTable.SetBorder(true).SetTitle("Dimensions (Enter: add filter)")
+	panel.gui.mainGrid.AddItem(panel.gui.dimensionsTable, 3, 1, 1, 2, 0, 0, false)
+	panel.setPlot(panel.newPlot(metricExplorerRanges[2], nil))
+	panel.gui.legendTable.SetBorder(true).SetTitle("Series")
+	panel.gui.mainGrid.AddItem(panel.gui.legendTable, 4, 1, 1, 5, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *MetricsExplorerPanel) makeKeyBindings() {
+	panel.gui.namespaceSelect.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.refreshButton, panel.gui.legendTable, nil))
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.queryInput, panel.gui.namespaceSelect, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+	panel.gui.queryInput.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEnter == key {
+			panel.runQuery()
+			return
+		}
+		panel.guiController.BindDefaultDoneFunc(panel.gui.rangeSelect, panel.gui.refreshButton, nil)(key)
+	})
+	panel.gui.rangeSelect.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.runButton, panel.gui.queryInput, nil))
+	panel.gui.runButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.metricsTable, panel.gui.rangeSelect, nil))
+	panel.gui.runButton.SetSelectedFunc(panel.runQuery)
+
+	panel.gui.metricsTable.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		case tcell.KeyTab:
+			panel.guiController.SetFocus(panel.gui.dimensionsTable)
+		case tcell.KeyBacktab:
+			panel.guiController.SetFocus(panel.gui.runButton)
+		}
+	})
+	panel.gui.metricsTable.SetSelectedFunc(func(row, column int) {
+		if row < 1 || row > len(panel.metricNames) {
+			return
+		}
+		panel.selectMetric(panel.metricNames[row-1])
+	})
+	panel.gui.dimensionsTable.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		case tcell.KeyTab:
+			panel.guiController.SetFocus(panel.gui.legendTable)
+		case tcell.KeyBacktab:
+			panel.guiController.SetFocus(panel.gui.metricsTable)
+		}
+	})
+	panel.gui.dimensionsTable.SetSelectedFunc(func(row, column int) {
+		if row < 1 || row > len(panel.dimensions) {
+			return
+		}
+		dimension := panel.dimensions[row-1]
+		panel.gui.queryInput.SetText(addQueryDimension(panel.gui.queryInput.GetText(), dimension[0], dimension[1]))
+		panel.guiController.SetFocus(panel.gui.queryInput)
+	})
+	panel.gui.legendTable.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		case tcell.KeyTab:
+			panel.guiController.SetFocus(panel.gui.namespaceSelect)
+		case tcell.KeyBacktab:
+			panel.guiController.SetFocus(panel.gui.dimensionsTable)
+		}
+	})
+}
+
+func (panel *MetricsExplorerPanel) getSelectedNamespace() string {
+	idx, _ := panel.gui.namespaceSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.namespaces) {
+		return ""
+	}
+	return panel.namespaces[idx]
+}
+
+func (panel *MetricsExplorerPanel) getSelectedRange() metricRange {
+	idx, _ := panel.gui.rangeSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(metricExplorerRanges) {
+		return metricExplorerRanges[0]
+	}
+	return metricExplorerRanges[idx]
+}
+
+// Reloads namespaces of compartment and metrics of selected namespace.
+func (panel *MetricsExplorerPanel) reload() {
+	selected := panel.getSelectedNamespace()
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.metricsTable)
+			panel.guiController.RefreshGUI()
+		}()
+		namespaces, err := panel.ociController.ListMetricNamespaces(panel.compartmentId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.namespaces = namespaces
+		selIdx := 0
+		for idx, namespace := range namespaces {
+			if namespace == selected {
+				selIdx = idx
+			}
+		}
+		// selected func is set after options so metrics are not loaded twice
+		panel.gui.namespaceSelect.SetSelectedFunc(nil)
+		panel.gui.namespaceSelect.SetOptions(namespaces, nil)
+		panel.gui.namespaceSelect.SetCurrentOption(selIdx)
+		panel.gui.namespaceSelect.SetSelectedFunc(func(text string, index int) {
+			panel.reloadMetrics()
+		})
+		if err := panel.loadMetrics(); err != nil {
+			panel.guiController.LogError(err.Error(), true)
+		}
+	}()
+}
+
+// Reloads metric names of selected namespace.
+func (panel *MetricsExplorerPanel) reloadMetrics() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.metricsTable)
+			panel.guiController.RefreshGUI()
+		}()
+		if err := panel.loadMetrics(); err != nil {
+			panel.guiController.LogError(err.Error(), true)
+		}
+	}()
+}
+
+func (panel *MetricsExplorerPanel) loadMetrics() error {
+	panel.metricNames = nil
+	panel.dimensions = nil
+	if namespace := panel.getSelectedNamespace(); namespace != "" {
+		names, err := panel.ociController.ListMetricNames(panel.compartmentId, namespace)
+		if err != nil {
+			return err
+		}
+		panel.metricNames = names
+	}
+	panel.refreshMetricsTable()
+	panel.refreshDimensionsTable()
+	return nil
+}
+
+// Loads dimensions of metric and prefills query with it.
+func (panel *MetricsExplorerPanel) selectMetric(name string) {
+	namespace := panel.getSelectedNamespace()
+	panel.gui.queryInput.SetText(fmt.Sprintf("%s[%s].mean()", name, panel.getSelectedRange().interval))
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.dimensionsTable)
+			panel.guiController.RefreshGUI()
+		}()
+		dimensions, err := panel.ociController.ListMetricDimensions(panel.compartmentId, namespace, name)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		keys := make([]string, 0, len(dimensions))
+		for key := range dimensions {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		panel.dimensions = nil
+		for _, key := range keys {
+			for _, value := range dimensions[key] {
+				panel.dimensions = append(panel.dimensions, [2]string{key, value})
+			}
+		}
+		panel.refreshDimensionsTable()
+	}()
+}
+
+func (panel *MetricsExplorerPanel) refreshMetricsTable() {
+	table := panel.gui.metricsTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	table.SetCell(0, 0, tview.NewTableCell("NAME").SetAlign(tview.AlignCenter).SetSelectable(false))
+	for row, name := range panel.metricNames {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		table.SetCell(row, 0, tview.NewTableCell(tview.Escape(name)).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+	}
+}
+
+func (panel *MetricsExplorerPanel) refreshDimensionsTable() {
+	table := panel.gui.dimensionsTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"DIMENSION", "VALUE"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, dimension := range panel.dimensions {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		table.SetCell(row, 0, tview.NewTableCell(tview.Escape(dimension[0])).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(dimension[1])).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+	}
+}
+
+// Adds dimension filter to the query, e.g. CpuUtilization[1m].mean() to CpuUtilization[1m]{resourceId = "ocid"}.mean().
+func addQueryDimension(query string, key string, value string) string {
+	filter := fmt.Sprintf("%s = \"%s\"", key, value)
+	if idx := strings.Index(query, "}"); idx >= 0 {
+		return query[:idx] + ", " + filter + query[idx:]
+	}
+	if idx := strings.Index(query, "]"); idx >= 0 {
+		return query[:idx+1] + "{" + filter + "}" + query[idx+1:]
+	}
+	return query
+}
+
+// Runs query in selected namespace over selected range and plots all returned streams.
+func (panel *MetricsExplorerPanel) runQuery() {
+	namespace := panel.getSelectedNamespace()
+	query := strings.TrimSpace(panel.gui.queryInput.GetText())
+	if namespace == "" || query == "" {
+		panel.guiController.LogError("namespace and query are required", true)
+		return
+	}
+	selectedRange := panel.getSelectedRange()
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.legendTable)
+			panel.guiController.RefreshGUI()
+		}()
+		end := time.Now()
+		series, err := panel.ociController.QueryMetrics(panel.compartmentId, namespace, query, end.Add(-selectedRange.duration), end)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		plot := panel.newPlot(selectedRange, series)
+		panel.guiController.QueueUpdateDraw(func() {
+			panel.setPlot(plot)
+			panel.refreshLegendTable(series)
+		})
+	}()
+}
+
+// Creates plot of all series, series are told apart by color of their points.
+// Plot cannot be cleared so new one replaces the old one on every query.
+func (panel *MetricsExplorerPanel) newPlot(selectedRange metricRange, series []oci.MetricSeries) *gui.DotPlot {
+	plot := gui.NewDotPlot()
+	plot.SetBorder(true).SetTitle(fmt.Sprintf(" Last %s ", selectedRange.name))
+	plot.SetXAxisText("Time", 0)
+	plot.SetYAxisText("Value", 1)
+	plot.SetAxis2String(func(value float64) string {
+		return time.Unix(int64(value), 0).Format(selectedRange.timeFormat)
+	}, func(value float64) string {
+		return fmt.Sprintf("%.2f", value)
+	})
+	data, owners := mergeSeriesPoints(series)
+	plot.SetStyleForPointFunc(func(point []float64) tcell.Style {
+		return tcell.StyleDefault.Foreground(seriesColors[owners[[2]float64{point[0], point[1]}]%len(seriesColors)])
+	})
+	if len(data) < 2 {
+		plot.SetNoDataText("No data")
+	} else {
+		plot.SetData(data)
+	}
+	return plot
+}
+
+func (panel *MetricsExplorerPanel) setPlot(plot *gui.DotPlot) {
+	if panel.gui.plot != nil {
+		panel.gui.mainGrid.RemoveItem(panel.gui.plot)
+	}
+	panel.gui.plot = plot
+	panel.gui.mainGrid.AddItem(panel.gui.plot, 2, 3, 2, 3, 0, 0, false)
+}
+
+// Merges points of all series to one data set for plotting, owners maps each point to index of its series.
+func mergeSeriesPoints(series []oci.MetricSeries) (data [][]float64, owners map[[2]float64]int) {
+	owners = make(map[[2]float64]int)
+	for idx, s := range series {
+		for _, point := range s.Points {
+			data = append(data, point)
+			owners[[2]float64{point[0], point[1]}] = idx
+		}
+	}
+	return data, owners
+}
+
+func (panel *MetricsExplorerPanel) refreshLegendTable(series []oci.MetricSeries) {
+	table := panel.gui.legendTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"", "SERIES", "POINTS", "MIN", "MAX", "LAST"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, s := range series {
+		min, max, last := math.NaN(), math.NaN(), math.NaN()
+		for idx, point := range s.Points {
+			if idx == 0 || point[1] < min {
+				min = point[1]
+			}
+			if idx == 0 || point[1] > max {
+				max = point[1]
+			}
+			last = point[1]
+		}
+		row += 1
+		table.SetCell(row, 0, tview.NewTableCell("■").SetTextColor(seriesColors[(row-1)%len(seriesColors)]))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(s.Label())).SetAlign(tview.AlignLeft))
+		table.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("%d", len(s.Points))).SetAlign(tview.AlignRight))
+		table.SetCell(row, 3, tview.NewTableCell(fmt.Sprintf("%.2f", min)).SetAlign(tview.AlignRight))
+		table.SetCell(row, 4, tview.NewTableCell(fmt.Sprintf("%.2f", max)).SetAlign(tview.AlignRight))
+		table.SetCell(row, 5, tview.NewTableCell(fmt.Sprintf("%.2f", last)).SetAlign(tview.AlignRight))
+	}
+}
+
+func (panel *MetricsExplorerPanel) GetPanelName() string {
+	return "metrics"
+}
+
+func (panel *MetricsExplorerPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *MetricsExplorerPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *MetricsExplorerPanel) GetInfo() string {
+	return "[red]Esc:[white] Exit [green]Enter:[white] Select metric / add dimension filter / run query [green]Tab:[white] Next"
+}
@@ -2,6 +2,10 @@ package gui
 
 import (
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -12,25 +16,159 @@ import (
 	"github.com/rivo/tview"
 )
 
+const (
+	monitoringCustomRange  = "custom"
+	monitoringAutoInterval = "auto"
+	monitoringTimeLayout   = "2006-01-02 15:04"
+	// number of points auto interval aims for, about width of the plot
+	monitoringAutoPoints = 150
+	// explicitly selected interval is made longer when it would give more points
+	monitoringMaxPoints = 1440
+)
+
+// Time ranges of instance monitoring, the last one is custom range given by start and end.
+var monitoringRanges = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"1 h", time.Hour},
+	{"3 h", 3 * time.Hour},
+	{"6 h", 6 * time.Hour},
+	{"12 h", 12 * time.Hour},
+	{"24 h", 24 * time.Hour},
+	{"3 d", 3 * 24 * time.Hour},
+	{"7 d", 7 * 24 * time.Hour},
+	{"14 d", 14 * 24 * time.Hour},
+	{"30 d", 30 * 24 * time.Hour},
+	{"90 d", 90 * 24 * time.Hour},
+	{monitoringCustomRange, 0},
+}
+
+// Intervals supported by MQL, ordered from the shortest one.
+var monitoringIntervals = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"1m", time.Minute},
+	{"5m", 5 * time.Minute},
+	{"10m", 10 * time.Minute},
+	{"15m", 15 * time.Minute},
+	{"30m", 30 * time.Minute},
+	{"1h", time.Hour},
+	{"3h", 3 * time.Hour},
+	{"6h", 6 * time.Hour},
+	{"12h", 12 * time.Hour},
+	{"1d", 24 * time.Hour},
+}
+
+var monitoringStatistics = []string{"mean", "max", "min", "sum", "count", "percentile"}
+
+// How often data are queried in live mode.
+var monitoringLiveIntervals = []struct {
+	name     string
+	duration time.Duration
+}{
+	{"10 s", 10 * time.Second},
+	{"30 s", 30 * time.Second},
+	{"1 m", time.Minute},
+	{"5 m", 5 * time.Minute},
+}
+
+// Chart of one metric, percent metrics are colored by utilization.
+type monitoringChart struct {
+	title   string
+	metric  string
+	unit    string
+	percent bool
+}
+
+// Set of charts shown together, views are switched in view dropdown.
+// Metrics of view are queried for resourceId, instance id if it is empty.
+// Grouped views have more streams per resource (per device, per VNIC), they are aggregated into one.
+type monitoringView struct {
+	name       string
+	title      string
+	namespace  string
+	resourceId string
+	grouped    bool
+	charts     []monitoringChart
+}
+
+// Views of oci_computeagent metrics of instance, views of attached volumes are added after them.
+var computeAgentViews = []monitoringView{
+	{"CPU / Memory", "Instance cpu/memory", "oci_computeagent", "", false, []monitoringChart{
+		{"CPU", "CpuUtilization", "% of CPU", true},
+		{"Memory", "MemoryUtilization", "% of Memory", true},
+	}},
+	{"Disk", "Instance disk", "oci_computeagent", "", true, []monitoringChart{
+		{"Read bytes", "DiskBytesRead", "bytes", false},
+		{"Write bytes", "DiskBytesWritten", "bytes", false},
+		{"Read IOPS", "DiskIopsRead", "IOPS", false},
+		{"Write IOPS", "DiskIopsWritten", "IOPS", false},
+	}},
+	{"Network", "Instance network", "oci_computeagent", "", true, []monitoringChart{
+		{"Bytes in", "NetworksBytesIn", "bytes", false},
+		{"Bytes out", "NetworksBytesOut", "bytes", false},
+	}},
+	{"Load", "Instance load average", "oci_computeagent", "", false, []monitoringChart{
+		{"Load average", "LoadAverage", "load", false},
+	}},
+}
+
+// Charts of oci_blockstore metrics of volume.
+var blockstoreCharts = []monitoringChart{
+	{"Read throughput", "VolumeReadThroughput", "bytes", false},
+	{"Write throughput", "VolumeWriteThroughput", "bytes", false},
+	{"Read ops", "VolumeReadOps", "ops", false},
+	{"Write ops", "VolumeWriteOps", "ops", false},
+}
+
 type InstanceMonitoringPanel struct {
 	guiController *GuiController
 	ociController *oci.OCIController
 	gui           *instanceMonitoringGUI
 	data          *instanceMonitoringData
+	views         []monitoringView
+	volumesLoaded bool
+	live          bool
+	liveMu        sync.Mutex
+	stop          chan struct{}
+	stopOnce      sync.Once
 }
 
 type instanceMonitoringData struct {
 	instance      *core.Instance
 	compartmentId string
-	memoryData    *[][]float64
-	cpuData       *[][]float64
+	// shown view with points of its charts sorted by time
+	view   monitoringView
+	series [][][]float64
+	mu     sync.Mutex
 }
 
 type instanceMonitoringGUI struct {
-	memoryBar  *gui.BarPlot
-	cpuBar     *gui.BarPlot
-	mainGrid   *tview.Grid
-	exitButton *tview.Button
+	charts          *tview.Grid
+	mainGrid        *tview.Grid
+	grid            *tview.Grid
+	viewSelect      *tview.DropDown
+	rangeSelect     *tview.DropDown
+	startInput      *tview.InputField
+	endInput        *tview.InputField
+	intervalSelect  *tview.DropDown
+	statisticSelect *tview.DropDown
+	percentileInput *tview.InputField
+	liveCheck       *tview.Checkbox
+	liveSelect      *tview.DropDown
+	applyButton     *tview.Button
+	exitButton      *tview.Button
+}
+
+// Query settings of instance monitoring chosen in controls.
+type instanceMonitoringQuery struct {
+	start      time.Time
+	end        time.Time
+	interval   string
+	statistic  string
+	percentile float64
 }
 
 func NewInstanceMonitoringPanel(GuiController *GuiController, OciController *oci.OCIController, Instance *core.Instance, CompartmentId string) *InstanceMonitoringPanel {
@@ -39,6 +177,8 @@ func NewInstanceMonitoringPanel(GuiController *GuiController, OciController *oci
 		ociController: OciController,
 		gui:           newInstanceMonitoringGUI(),
 		data:          newInstanceMonitoringData(Instance, CompartmentId),
+		views:         append([]monitoringView{}, computeAgentViews...),
+		stop:          make(chan struct{}),
 	}
 	res.createGUI()
 	return &res
@@ -48,50 +188,89 @@ func newInstanceMonitoringData(inst *core.Instance, compId string) *instanceMoni
 	res := instanceMonitoringData{
 		instance:      inst,
 		compartmentId: compId,
-		memoryData:    nil,
-		cpuData:       nil,
 	}
 	return &res
 }
 
 func newInstanceMonitoringGUI() *instanceMonitoringGUI {
 	res := instanceMonitoringGUI{
-		memoryBar:  gui.NewBarPlot(),
-		cpuBar:     gui.NewBarPlot(),
-		mainGrid:   tview.NewGrid(),
-		exitButton: tview.NewButton("Close"),
+		mainGrid:        tview.NewGrid(),
+		grid:            tview.NewGrid(),
+		viewSelect:      tview.NewDropDown(),
+		rangeSelect:     tview.NewDropDown(),
+		startInput:      tview.NewInputField().SetPlaceholder(monitoringTimeLayout),
+		endInput:        tview.NewInputField().SetPlaceholder(monitoringTimeLayout),
+		intervalSelect:  tview.NewDropDown(),
+		statisticSelect: tview.NewDropDown(),
+		percentileInput: tview.NewInputField().SetAcceptanceFunc(tview.InputFieldFloat).SetText("95"),
+		liveCheck:       tview.NewCheckbox(),
+		liveSelect:      tview.NewDropDown(),
+		applyButton:     tview.NewButton("Apply"),
+		exitButton:      tview.NewButton("Close"),
 	}
-	res.memoryBar.SetAxis2String(func(value float64) string {
-		return time.Unix(int64(value), 0).Format("15:04")
-	}, func(value float64) string {
-		return fmt.Sprintf("%.2f", value)
-	})
-	res.cpuBar.SetAxis2String(func(value float64) string {
-		return time.Unix(int64(value), 0).Format("15:04")
+	return &res
+}
+
+// Creates plot of chart with time axis in given format, y axis text depends on statistic.
+// Plots cannot be cleared so new ones replace old ones on every load.
+func newMonitoringBarPlot(chart monitoringChart, timeFormat string, statistic string) *gui.BarPlot {
+	bar := gui.NewBarPlot()
+	bar.SetAxis2String(func(value float64) string {
+		return time.Unix(int64(value), 0).Format(timeFormat)
 	}, func(value float64) string {
-		return fmt.Sprintf("%.2f", value)
+		if chart.percent {
+			return fmt.Sprintf("%.2f", value)
+		}
+		return shortNumber(value)
 	})
 
-	pointStyle := func(point []float64) tcell.Style {
-		if point[1] < 50 {
-			return tcell.StyleDefault.Background(tcell.ColorGreen)
-		} else if point[1] < 60 {
-			return tcell.StyleDefault.Background(tcell.ColorDarkGreen)
-		} else if point[1] < 70 {
-			return tcell.StyleDefault.Background(tcell.ColorGreenYellow)
-		} else if point[1] < 80 {
-			return tcell.StyleDefault.Background(tcell.ColorLightYellow)
-		} else if point[1] < 90 {
-			return tcell.StyleDefault.Background(tcell.ColorYellow)
-		} else {
-			return tcell.StyleDefault.Background(tcell.ColorRed)
-		}
+	if chart.percent && statistic != "sum" && statistic != "count" {
+		bar.SetStyleForPointFunc(func(point []float64) tcell.Style {
+			if point[1] < 50 {
+				return tcell.StyleDefault.Background(tcell.ColorGreen)
+			} else if point[1] < 60 {
+				return tcell.StyleDefault.Background(tcell.ColorDarkGreen)
+			} else if point[1] < 70 {
+				return tcell.StyleDefault.Background(tcell.ColorGreenYellow)
+			} else if point[1] < 80 {
+				return tcell.StyleDefault.Background(tcell.ColorLightYellow)
+			} else if point[1] < 90 {
+				return tcell.StyleDefault.Background(tcell.ColorYellow)
+			} else {
+				return tcell.StyleDefault.Background(tcell.ColorRed)
+			}
+		})
+	} else {
+		bar.SetStyleForPointFunc(func(point []float64) tcell.Style {
+			return tcell.StyleDefault.Background(tcell.ColorLightBlue)
+		})
 	}
 
-	res.memoryBar.SetStyleForPointFunc(pointStyle)
-	res.cpuBar.SetStyleForPointFunc(pointStyle)
+	bar.SetBorder(true).SetTitle(" " + chart.title + " ")
+	bar.SetXAxisText("Time", 0)
+	switch {
+	case statistic == "count":
+		bar.SetYAxisText(chart.title+" count", 1)
+	case statistic == "sum" && chart.percent:
+		bar.SetYAxisText(chart.title+" sum", 1)
+	default:
+		bar.SetYAxisText(chart.unit, 1)
+	}
+	return bar
+}
 
-	return &res
+// Formats value with K, M, G suffix, e.g. 1536000 to 1.54M.
+func shortNumber(value float64) string {
+	abs := math.Abs(value)
+	switch {
+	case abs >= 1e9:
+		return fmt.Sprintf("%.2fG", value/1e9)
+	case abs >= 1e6:
+		return fmt.Sprintf("%.2fM", value/1e6)
+	case abs >= 1e3:
+		return fmt.Sprintf("%.2fK", value/1e3)
+	}
+	return fmt.Sprintf("%.2f", value)
 }
 
 func (panel *InstanceMonitoringPanel) GetGUI() tview.Primitive {
@@ -103,58 +282,491 @@ func (panel *InstanceMonitoringPanel) GetPanelName() string {
 }
 
 func (panel *InstanceMonitoringPanel) createGUI() {
-	grid := tview.NewGrid()
+	grid := panel.gui.grid
 	grid.SetColumns(82, 8, 82)
-	grid.SetRows(20, 20, 1)
+	grid.SetRows(3, 20, 20, 1)
 
 	panel.gui.mainGrid.SetColumns(0, 172, 0)
-	panel.gui.mainGrid.SetRows(0, 41, 0)
-
-	panel.gui.cpuBar.SetBorder(true).SetTitle(" CPU ")
-	panel.gui.memoryBar.SetBorder(true).SetTitle(" Memory ")
+	panel.gui.mainGrid.SetRows(0, 46, 0)
 
-	panel.gui.cpuBar.SetXAxisText("Time", 0)
-	panel.gui.cpuBar.SetYAxisText("% of CPU", 1)
+	panel.gui.viewSelect.SetOptions(panel.viewNames(), nil).SetCurrentOption(0)
+	panel.gui.viewSelect.SetBorder(true).SetTitle("View")
+	var ranges []string
+	for _, r := range monitoringRanges {
+		ranges = append(ranges, r.name)
+	}
+	panel.gui.rangeSelect.SetOptions(ranges, nil).SetCurrentOption(4)
+	panel.gui.rangeSelect.SetBorder(true).SetTitle("Last")
+	panel.gui.startInput.SetBorder(true).SetTitle("Custom start")
+	panel.gui.endInput.SetBorder(true).SetTitle("Custom end")
+	intervals := []string{monitoringAutoInterval}
+	for _, i := range monitoringIntervals {
+		intervals = append(intervals, i.name)
+	}
+	panel.gui.intervalSelect.SetOptions(intervals, nil).SetCurrentOption(3)
+	panel.gui.intervalSelect.SetBorder(true).SetTitle("Interval")
+	panel.gui.statisticSelect.SetOptions(monitoringStatistics, nil).SetCurrentOption(1)
+	panel.gui.statisticSelect.SetBorder(true).SetTitle("Statistic")
+	panel.gui.percentileInput.SetBorder(true).SetTitle("Percentile")
+	panel.gui.liveCheck.SetBorder(true).SetTitle("Live")
+	var liveIntervals []string
+	for _, i := range monitoringLiveIntervals {
+		liveIntervals = append(liveIntervals, i.name)
+	}
+	panel.gui.liveSelect.SetOptions(liveIntervals, nil).SetCurrentOption(0)
+	panel.gui.liveSelect.SetBorder(true).SetTitle("Every")
 
-	panel.gui.memoryBar.SetXAxisText("Time", 0)
-	panel.gui.memoryBar.SetYAxisText("% of Memory", 1)
+	controls := tview.NewGrid().SetColumns(24, 10, 20, 20, 12, 14, 12, 8, 10, 0)
+	controls.AddItem(panel.gui.viewSelect, 0, 0, 1, 1, 0, 0, false)
+	controls.AddItem(panel.gui.rangeSelect, 0, 1, 1, 1, 0, 0, false)
+	controls.AddItem(panel.gui.startInput, 0, 2, 1, 1, 0, 0, false)
+	controls.AddItem(panel.gui.endInput, 0, 3, 1, 1, 0, 0, false)
+	controls.AddItem(panel.gui.intervalSelect, 0, 4, 1, 1, 0, 0, false)
+	controls.AddItem(panel.gui.statisticSelect, 0, 5, 1, 1, 0, 0, false)
+	controls.AddItem(panel.gui.percentileInput, 0, 6, 1, 1, 0, 0, false)
+	controls.AddItem(panel.gui.liveCheck, 0, 7, 1, 1, 0, 0, false)
+	controls.AddItem(panel.gui.liveSelect, 0, 8, 1, 1, 0, 0, false)
+	controls.AddItem(WrapButton(panel.gui.applyButton), 0, 9, 1, 1, 0, 0, false)
 
-	grid.AddItem(panel.gui.cpuBar, 0, 0, 1, 3, 0, 0, false)
-	grid.AddItem(panel.gui.memoryBar, 1, 0, 1, 3, 0, 0, false)
-	grid.AddItem(panel.gui.exitButton, 2, 1, 1, 1, 0, 0, true)
+	grid.AddItem(controls, 0, 0, 1, 3, 0, 0, false)
+	panel.setCharts(tview.NewGrid())
+	grid.AddItem(panel.gui.exitButton, 3, 1, 1, 1, 0, 0, true)
 	// placeholders
-	grid.AddItem(tview.NewTextView().SetBorder(false), 2, 0, 1, 1, 0, 0, false)
-	grid.AddItem(tview.NewTextView().SetBorder(false), 2, 2, 1, 1, 0, 0, false)
+	grid.AddItem(tview.NewTextView().SetBorder(false), 3, 0, 1, 1, 0, 0, false)
+	grid.AddItem(tview.NewTextView().SetBorder(false), 3, 2, 1, 1, 0, 0, false)
 
-	grid.SetBorder(true).SetTitle("Instance cpu/memory max over 10 min from last 24 h")
+	grid.SetBorder(true).SetTitle("Instance cpu/memory max over 10m from last 24 h")
 
 	panel.gui.mainGrid.AddItem(grid, 1, 1, 1, 1, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+// Binds navigation between controls, exit button is bound by the panel opening monitoring.
+func (panel *InstanceMonitoringPanel) makeKeyBindings() {
+	panel.gui.viewSelect.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.rangeSelect, panel.gui.exitButton, panel.gui.exitButton))
+	panel.gui.viewSelect.SetSelectedFunc(func(text string, index int) {
+		panel.reload()
+	})
+	panel.gui.rangeSelect.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.startInput, panel.gui.viewSelect, panel.gui.exitButton))
+	panel.gui.startInput.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.endInput, panel.gui.rangeSelect, panel.gui.exitButton))
+	panel.gui.endInput.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.intervalSelect, panel.gui.startInput, panel.gui.exitButton))
+	panel.gui.intervalSelect.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.statisticSelect, panel.gui.endInput, panel.gui.exitButton))
+	panel.gui.statisticSelect.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.percentileInput, panel.gui.intervalSelect, panel.gui.exitButton))
+	panel.gui.percentileInput.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.liveCheck, panel.gui.statisticSelect, panel.gui.exitButton))
+	panel.gui.liveCheck.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.liveSelect, panel.gui.percentileInput, panel.gui.exitButton))
+	panel.gui.liveCheck.SetChangedFunc(func(checked bool) {
+		if !checked {
+			return
+		}
+		if idx, _ := panel.gui.rangeSelect.GetCurrentOption(); monitoringRanges[idx].name == monitoringCustomRange {
+			panel.gui.liveCheck.SetChecked(false)
+			panel.guiController.LogErrorOnPage("live mode needs relative time range", panel.GetPanelName(), panel.gui.liveCheck)
+			return
+		}
+		panel.scheduleLive()
+	})
+	panel.gui.liveSelect.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.applyButton, panel.gui.liveCheck, panel.gui.exitButton))
+	panel.gui.applyButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.exitButton, panel.gui.liveSelect, panel.gui.exitButton))
+	panel.gui.applyButton.SetSelectedFunc(panel.reload)
+}
+
+// Loads data in background, used by controls once the panel is shown.
+func (panel *InstanceMonitoringPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.exitButton)
+			panel.guiController.RefreshGUI()
+		}()
+		panel.LoadData()
+	}()
+}
+
+func (panel *InstanceMonitoringPanel) viewNames() []string {
+	var names []string
+	for _, view := range panel.views {
+		names = append(names, view.name)
+	}
+	return names
+}
+
+func (panel *InstanceMonitoringPanel) getSelectedView() monitoringView {
+	idx, _ := panel.gui.viewSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.views) {
+		return panel.views[0]
+	}
+	return panel.views[idx]
+}
+
+// Adds view of oci_blockstore metrics for every volume attached to the instance.
+// Volumes are loaded only once, if they cannot be listed only compute agent views are shown.
+func (panel *InstanceMonitoringPanel) loadVolumeViews() {
+	if panel.volumesLoaded {
+		return
+	}
+	instance := panel.data.instance
+	volumes, err := panel.ociController.ListInstanceStorage(panel.data.compartmentId, *instance.AvailabilityDomain, *instance.Id)
+	if err != nil {
+		panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.gui.exitButton)
+		return
+	}
+	panel.volumesLoaded = true
+	for _, volume := range volumes {
+		panel.views = append(panel.views, monitoringView{
+			name:       "Volume " + volume.Name,
+			title:      "Volume " + volume.Name,
+			namespace:  "oci_blockstore",
+			resourceId: volume.VolumeId,
+			charts:     blockstoreCharts,
+		})
+	}
+	idx, _ := panel.gui.viewSelect.GetCurrentOption()
+	// selected func would load data again when current option is set
+	panel.gui.viewSelect.SetSelectedFunc(nil)
+	panel.gui.viewSelect.SetOptions(panel.viewNames(), nil)
+	panel.gui.viewSelect.SetCurrentOption(idx)
+	panel.gui.viewSelect.SetSelectedFunc(func(text string, index int) {
+		panel.reload()
+	})
+}
+
+// Returns query settings from controls.
+func (panel *InstanceMonitoringPanel) getQuery() (*instanceMonitoringQuery, error) {
+	query := instanceMonitoringQuery{end: time.Now()}
+	idx, _ := panel.gui.rangeSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(monitoringRanges) {
+		idx = 0
+	}
+	if monitoringRanges[idx].name == monitoringCustomRange {
+		start, err := time.ParseInLocation(monitoringTimeLayout, strings.TrimSpace(panel.gui.startInput.GetText()), time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("custom start has to be in format %s", monitoringTimeLayout)
+		}
+		query.start = start
+		if endText := strings.TrimSpace(panel.gui.endInput.GetText()); endText != "" {
+			end, err := time.ParseInLocation(monitoringTimeLayout, endText, time.Local)
+			if err != nil {
+				return nil, fmt.Errorf("custom end has to be in format %s", monitoringTimeLayout)
+			}
+			query.end = end
+		}
+		if !query.start.Before(query.end) {
+			return nil, fmt.Errorf("custom start has to be before end")
+		}
+	} else {
+		query.start = query.end.Add(-monitoringRanges[idx].duration)
+	}
+	_, query.interval = panel.gui.intervalSelect.GetCurrentOption()
+	duration := query.end.Sub(query.start)
+	if query.interval == monitoringAutoInterval || query.interval == "" {
+		query.interval = autoMonitoringInterval(duration)
+	} else if duration/monitoringIntervalDuration(query.interval) > monitoringMaxPoints {
+		// e.g. 90 d at 1m would be too many points, the interval is clamped to the range
+		query.interval = shortestMonitoringInterval(duration, monitoringMaxPoints)
+	}
+	_, query.statistic = panel.gui.statisticSelect.GetCurrentOption()
+	if query.statistic == "percentile" {
+		percentile, err := strconv.ParseFloat(panel.gui.percentileInput.GetText(), 64)
+		if err != nil || percentile <= 0 || percentile >= 100 {
+			return nil, fmt.Errorf("percentile has to be between 0 and 100")
+		}
+		query.percentile = percentile / 100
+	}
+	return &query, nil
+}
+
+// Returns the shortest interval giving at most monitoringAutoPoints points for duration.
+func autoMonitoringInterval(duration time.Duration) string {
+	return shortestMonitoringInterval(duration, monitoringAutoPoints)
+}
+
+// Returns the shortest interval giving at most points for duration, the longest one if none does.
+func shortestMonitoringInterval(duration time.Duration, points int64) string {
+	for _, interval := range monitoringIntervals {
+		if int64(duration/interval.duration) <= points {
+			return interval.name
+		}
+	}
+	return monitoringIntervals[len(monitoringIntervals)-1].name
+}
+
+// Returns format of time axis readable for duration of plotted range.
+func monitoringTimeFormat(duration time.Duration) string {
+	switch {
+	case duration <= 24*time.Hour:
+		return "15:04"
+	case duration <= 7*24*time.Hour:
+		return "01/02 15h"
+	default:
+		return "01/02"
+	}
+}
+
+// Returns title of monitoring describing range, e.g. last 24 h or from start to end.
+func (query *instanceMonitoringQuery) title(name string) string {
+	statistic := query.statistic
+	if statistic == "percentile" {
+		statistic = fmt.Sprintf("p%g", query.percentile*100)
+	}
+	duration := query.end.Sub(query.start)
+	if time.Since(query.end) < time.Minute {
+		for _, r := range monitoringRanges {
+			if r.duration == duration.Round(time.Minute) {
+				return fmt.Sprintf("%s %s over %s from last %s", name, statistic, query.interval, r.name)
+			}
+		}
+	}
+	return fmt.Sprintf("%s %s over %s from %s to %s", name, statistic, query.interval,
+		query.start.Format(monitoringTimeLayout), query.end.Format(monitoringTimeLayout))
+}
+
+// Places grid of charts to panel instead of the current one.
+func (panel *InstanceMonitoringPanel) setCharts(charts *tview.Grid) {
+	if panel.gui.charts != nil {
+		panel.gui.grid.RemoveItem(panel.gui.charts)
+	}
+	panel.gui.charts = charts
+	panel.gui.grid.AddItem(panel.gui.charts, 1, 0, 2, 3, 0, 0, false)
+}
+
+// Creates grid of plots, one or two plots are placed under each other, more of them in two columns.
+func newMonitoringChartsGrid(plots []*gui.BarPlot) *tview.Grid {
+	charts := tview.NewGrid()
+	columns := 1
+	if len(plots) > 2 {
+		columns = 2
+	}
+	rows := (len(plots) + columns - 1) / columns
+	charts.SetColumns(make([]int, columns)...)
+	charts.SetRows(make([]int, rows)...)
+	for idx, plot := range plots {
+		charts.AddItem(plot, idx/columns, idx%columns, 1, 1, 0, 0, false)
+	}
+	return charts
 }
 
 func (panel *InstanceMonitoringPanel) LoadData() {
+	query, err := panel.getQuery()
+	if err != nil {
+		panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.gui.exitButton)
+		return
+	}
+	panel.loadVolumeViews()
+	view := panel.getSelectedView()
+
+	panel.data.mu.Lock()
+	defer panel.data.mu.Unlock()
+	panel.data.view = view
+	panel.data.series, err = panel.queryCharts(view, query, query.start, nil)
 
+	panel.setCharts(panel.newChartsGrid(query))
+	panel.gui.grid.SetTitle(panel.title(query))
+	if err != nil {
+		panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.gui.exitButton)
+	}
+}
+
+// Queries all charts of view from start to end of query, received points are merged with old series
+// and points out of query range are dropped. Charts failing to load keep old points, the first error is returned.
+func (panel *InstanceMonitoringPanel) queryCharts(view monitoringView, query *instanceMonitoringQuery, start time.Time, old [][][]float64) ([][][]float64, error) {
+	resourceId := view.resourceId
+	if resourceId == "" {
+		resourceId = *panel.data.instance.Id
+	}
+	series := make([][][]float64, len(view.charts))
+	errs := make([]error, len(view.charts))
 	var wg sync.WaitGroup
 
-	wg.Add(2)
-	go func() {
-		defer wg.Done()
-		data, err := panel.ociController.CpuUtilization10mLast24hMax(panel.data.compartmentId, *panel.data.instance.Id)
+	wg.Add(len(view.charts))
+	for idx, chart := range view.charts {
+		go func(idx int, chart monitoringChart) {
+			defer wg.Done()
+			merged := make(map[float64]float64)
+			if idx < len(old) {
+				for _, point := range old[idx] {
+					merged[point[0]] = point[1]
+				}
+			}
+			data, err := panel.ociController.ResourceMetric(panel.data.compartmentId, view.namespace, resourceId,
+				chart.metric, query.interval, view.grouped, query.statistic, query.percentile, start, query.end)
+			errs[idx] = err
+			for k, v := range data {
+				merged[k] = v
+			}
+			points := Float64MapToArray(merged)
+			sort.Slice(points, func(i, j int) bool {
+				return points[i][0] < points[j][0]
+			})
+			from := float64(query.start.Unix())
+			for len(points) > 0 && points[0][0] < from {
+				points = points[1:]
+			}
+			series[idx] = points
+		}(idx, chart)
+	}
+	wg.Wait()
+	for _, err := range errs {
 		if err != nil {
-			panel.gui.cpuBar.SetNoDataText("No data loaded.")
+			return series, err
+		}
+	}
+	return series, nil
+}
+
+// Creates plots of shown series, in live mode titles of plots show the latest value and its trend.
+func (panel *InstanceMonitoringPanel) newChartsGrid(query *instanceMonitoringQuery) *tview.Grid {
+	timeFormat := monitoringTimeFormat(query.end.Sub(query.start))
+	plots := make([]*gui.BarPlot, len(panel.data.view.charts))
+	for idx, chart := range panel.data.view.charts {
+		plot := newMonitoringBarPlot(chart, timeFormat, query.statistic)
+		if len(panel.data.series[idx]) < 2 {
+			plot.SetNoDataText("No data loaded.")
 		} else {
-			panel.gui.cpuBar.SetData(Float64MapToArray(data))
+			plot.SetData(panel.data.series[idx])
 		}
-	}()
+		if panel.gui.liveCheck.IsChecked() {
+			if latest := latestWithTrend(chart, panel.data.series[idx]); latest != "" {
+				plot.SetTitle(fmt.Sprintf(" %s %s ", chart.title, latest))
+			}
+		}
+		plots[idx] = plot
+	}
+	return newMonitoringChartsGrid(plots)
+}
+
+// Returns title of panel, in live mode with the latest values of all charts.
+func (panel *InstanceMonitoringPanel) title(query *instanceMonitoringQuery) string {
+	title := query.title(panel.data.view.title)
+	if !panel.gui.liveCheck.IsChecked() {
+		return title
+	}
+	_, every := panel.gui.liveSelect.GetCurrentOption()
+	var latest []string
+	for idx, chart := range panel.data.view.charts {
+		if value := latestWithTrend(chart, panel.data.series[idx]); value != "" {
+			latest = append(latest, chart.title+" "+value)
+		}
+	}
+	return fmt.Sprintf("%s, live every %s: %s", title, every, strings.Join(latest, "  "))
+}
+
+// Returns the latest value of series with arrow showing change from the previous one.
+func latestWithTrend(chart monitoringChart, points [][]float64) string {
+	if len(points) == 0 {
+		return ""
+	}
+	last := points[len(points)-1][1]
+	value := shortNumber(last)
+	if chart.percent {
+		value = fmt.Sprintf("%.2f%%", last)
+	}
+	if len(points) < 2 {
+		return value
+	}
+	previous := points[len(points)-2][1]
+	switch {
+	case last > previous:
+		return value + " ↑"
+	case last < previous:
+		return value + " ↓"
+	}
+	return value + " →"
+}
+
+func (panel *InstanceMonitoringPanel) getLiveInterval() time.Duration {
+	idx, _ := panel.gui.liveSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(monitoringLiveIntervals) {
+		idx = 0
+	}
+	return monitoringLiveIntervals[idx].duration
+}
+
+// Starts live refresh if live mode is on, only one live refresh is running at a time.
+// It stops when live mode is switched off or panel is stopped.
+func (panel *InstanceMonitoringPanel) scheduleLive() {
+	if !panel.gui.liveCheck.IsChecked() {
+		return
+	}
+	panel.liveMu.Lock()
+	defer panel.liveMu.Unlock()
+	if panel.live {
+		return
+	}
+	panel.live = true
 	go func() {
-		defer wg.Done()
-		data, err := panel.ociController.MemoryUtilization10mLast24hMax(panel.data.compartmentId, *panel.data.instance.Id)
-		if err != nil {
-			panel.gui.memoryBar.SetNoDataText("No data loaded.")
-		} else {
-			panel.gui.memoryBar.SetData(Float64MapToArray(data))
+		defer func() {
+			panel.liveMu.Lock()
+			panel.live = false
+			panel.liveMu.Unlock()
+		}()
+		for {
+			select {
+			case <-panel.stop:
+				return
+			case <-time.After(panel.getLiveInterval()):
+			}
+			if !panel.gui.liveCheck.IsChecked() {
+				return
+			}
+			panel.refreshLive()
 		}
 	}()
-	wg.Wait()
+}
+
+// Queries only the newest points of shown view, appends them to series and scrolls range to now.
+func (panel *InstanceMonitoringPanel) refreshLive() {
+	if idx, _ := panel.gui.rangeSelect.GetCurrentOption(); monitoringRanges[idx].name == monitoringCustomRange {
+		return
+	}
+	query, err := panel.getQuery()
+	if err != nil {
+		panel.guiController.LogError(err.Error(), false)
+		return
+	}
+	panel.data.mu.Lock()
+	if panel.data.view.name == "" {
+		// nothing loaded yet
+		panel.data.mu.Unlock()
+		return
+	}
+	start := query.start
+	for _, points := range panel.data.series {
+		if len(points) > 0 {
+			// the last points may be still aggregated, so they are queried again
+			from := time.Unix(int64(points[len(points)-1][0]), 0).Add(-3 * monitoringIntervalDuration(query.interval))
+			if from.After(start) {
+				start = from
+			}
+			break
+		}
+	}
+	panel.data.series, err = panel.queryCharts(panel.data.view, query, start, panel.data.series)
+	if err != nil {
+		panel.guiController.LogError(err.Error(), false)
+	}
+	charts := panel.newChartsGrid(query)
+	title := panel.title(query)
+	panel.data.mu.Unlock()
+	panel.guiController.QueueUpdateDraw(func() {
+		panel.setCharts(charts)
+		panel.gui.grid.SetTitle(title)
+	})
+}
+
+func monitoringIntervalDuration(name string) time.Duration {
+	for _, interval := range monitoringIntervals {
+		if interval.name == name {
+			return interval.duration
+		}
+	}
+	return time.Minute
+}
+
+// Stops live refresh, called when panel is closed.
+func (panel *InstanceMonitoringPanel) Stop() {
+	panel.stopOnce.Do(func() { close(panel.stop) })
 }
 
 func Float64MapToArray(floatMap map[float64]float64) [][]float64 {
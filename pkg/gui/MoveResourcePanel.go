@@ -0,0 +1,161 @@
+package gui
+
+import (
+	"fmt"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+// Panel moving resource to other compartment and tracking the move until it is finished.
+type MoveResourcePanel struct {
+	guiController     *GuiController
+	ociController     *oci.OCIController
+	resource          oci.ResourceSummary
+	compartments      []identity.Compartment
+	grid              *tview.Grid
+	form              *tview.Form
+	compartmentSelect *tview.DropDown
+	progressText      *tview.TextView
+	moving            bool
+	moved             bool
+	closeFunc         func(moved bool)
+}
+
+// Target compartments are root compartment (tenancy) followed by compartments of top panel.
+func NewMoveResourcePanel(GuiController *GuiController, OciController *oci.OCIController, TenancyId string, Resource oci.ResourceSummary) *MoveResourcePanel {
+	compartments := []identity.Compartment{{Id: common.String(TenancyId), Name: common.String("root (tenancy)")}}
+	for _, comp := range GuiController.GetGUITopPanel().GetCompartments() {
+		if *comp.Id != TenancyId {
+			compartments = append(compartments, comp)
+		}
+	}
+	res := MoveResourcePanel{
+		guiController:     GuiController,
+		ociController:     OciController,
+		resource:          Resource,
+		compartments:      compartments,
+		grid:              tview.NewGrid(),
+		form:              tview.NewForm(),
+		compartmentSelect: tview.NewDropDown().SetLabel("Target compartment:"),
+		progressText:      tview.NewTextView(),
+		closeFunc:         func(moved bool) {},
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *MoveResourcePanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *MoveResourcePanel) GetPanelName() string {
+	return "MoveResourcePanel"
+}
+
+func (panel *MoveResourcePanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+// Function called when panel is closed, moved is true if resource was moved.
+func (panel *MoveResourcePanel) SetCloseFunc(close func(moved bool)) {
+	panel.closeFunc = close
+}
+
+func (panel *MoveResourcePanel) createGUI() {
+	txt := make([]string, 0)
+	selIdx := 0
+	for idx, comp := range panel.compartments {
+		txt = append(txt, *comp.Name)
+		if *comp.Id == panel.resource.CompartmentId {
+			selIdx = idx
+		}
+	}
+	panel.compartmentSelect.SetOptions(txt, nil)
+	panel.compartmentSelect.SetCurrentOption(selIdx)
+
+	ocid := tview.NewInputField().SetLabel("OCID:").SetText(panel.resource.Id)
+	name := tview.NewInputField().SetLabel("Name:").SetText(panel.resource.Name)
+	kind := tview.NewInputField().SetLabel("Type:").SetText(panel.resource.Type)
+
+	panel.form.AddFormItem(panel.compartmentSelect).
+		AddButton("Move", panel.move).
+		AddButton("Close", panel.close)
+	panel.form.SetCancelFunc(panel.close)
+
+	panel.progressText.SetDynamicColors(true).SetBorder(true).SetTitle("Progress")
+	if len(panel.compartments) == 1 {
+		panel.progressText.SetText("[yellow]compartments are not loaded yet, only root compartment is offered")
+	}
+
+	grid := tview.NewGrid()
+	grid.SetColumns(50, 50)
+	grid.SetRows(1, 1, 5, 0)
+	grid.AddItem(ocid, 0, 0, 1, 2, 0, 0, false)
+	grid.AddItem(name, 1, 0, 1, 1, 0, 0, false)
+	grid.AddItem(kind, 1, 1, 1, 1, 0, 0, false)
+	grid.AddItem(panel.form, 2, 0, 1, 2, 0, 0, true)
+	grid.AddItem(panel.progressText, 3, 0, 1, 2, 0, 0, false)
+	grid.SetBorder(true).SetTitle("Move Resource")
+
+	panel.grid.SetColumns(0, 100, 0)
+	panel.grid.SetRows(0, 15, 0)
+	panel.grid.AddItem(grid, 1, 1, 1, 1, 0, 0, true)
+}
+
+func (panel *MoveResourcePanel) close() {
+	if panel.moving {
+		panel.guiController.LogErrorOnPage("move is in progress, wait until it is finished", panel.GetPanelName(), panel.form)
+		return
+	}
+	panel.closeFunc(panel.moved)
+}
+
+func (panel *MoveResourcePanel) move() {
+	if panel.moving {
+		return
+	}
+	idx, compName := panel.compartmentSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.compartments) {
+		panel.guiController.LogErrorOnPage("target compartment has to be selected", panel.GetPanelName(), panel.form)
+		return
+	}
+	target := *panel.compartments[idx].Id
+	if target == panel.resource.CompartmentId {
+		panel.guiController.LogErrorOnPage("resource is already in selected compartment", panel.GetPanelName(), panel.form)
+		return
+	}
+	modalName := "ModalMoveResourcePanel"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to move %s %s to compartment %s?", panel.resource.Type, panel.resource.Name, compName)).
+		AddButtons([]string{"Move", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, panel.GetPanelName())
+			panel.guiController.SetFocus(panel.form)
+			if buttonLabel != "Move" {
+				return
+			}
+			panel.moving = true
+			panel.progressText.SetText("move requested")
+			go func() {
+				defer func() {
+					panel.moving = false
+					panel.guiController.RefreshGUI()
+				}()
+				err := panel.ociController.MoveResource(panel.resource.Type, panel.resource.Id, target, func(progress string) {
+					panel.progressText.SetText(progress)
+					panel.guiController.RefreshGUI()
+				})
+				if err != nil {
+					panel.progressText.SetText("[red]" + tview.Escape(err.Error()))
+					return
+				}
+				panel.moved = true
+				panel.resource.CompartmentId = target
+				panel.progressText.SetText(fmt.Sprintf("[green]moved to %s", compName))
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
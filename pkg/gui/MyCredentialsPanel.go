@@ -0,0 +1,245 @@
+package gui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/rivo/tview"
+)
+
+type myCredentialsGUI struct {
+	mainGrid      *tview.Grid
+	keyFileInput  *tview.InputField
+	uploadButton  *tview.Button
+	refreshButton *tview.Button
+	mainTable     *tview.Table
+}
+
+// Panel listing API keys of the user of active profile.
+// Allows rotation of keys: uploading new public key and deleting old keys.
+type MyCredentialsPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *myCredentialsGUI
+	keys          []oci.MyApiKey
+	tenancyId     string
+	compartmentId string
+}
+
+func NewMyCredentialsPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *MyCredentialsPanel {
+	res := MyCredentialsPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newMyCredentialsGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewMyCredentialsAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewMyCredentialsPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newMyCredentialsGUI() *myCredentialsGUI {
+	res := myCredentialsGUI{
+		mainGrid:      tview.NewGrid(),
+		keyFileInput:  tview.NewInputField(),
+		uploadButton:  tview.NewButton("Upload"),
+		refreshButton: tview.NewButton("Refresh"),
+		mainTable:     tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *MyCredentialsPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 60, 20, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 20)
+	panel.gui.keyFileInput.SetPlaceholder("~/.oci/oci_api_key_public.pem").
+		SetBorder(true).SetTitle("Public key file")
+	panel.gui.mainGrid.AddItem(panel.gui.keyFileInput, 1, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.uploadButton), 1, 2, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 3, 1, 1, 0, 0, false)
+
+	panel.gui.mainTable.SetBorder(true).SetTitle("My API Keys")
+	panel.gui.mainGrid.AddItem(panel.gui.mainTable, 2, 0, 1, 5, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *MyCredentialsPanel) makeKeyBindings() {
+	panel.gui.keyFileInput.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.uploadButton, panel.gui.refreshButton, nil))
+	panel.gui.uploadButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.refreshButton, panel.gui.keyFileInput, nil))
+	panel.gui.uploadButton.SetSelectedFunc(panel.upload)
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.keyFileInput, panel.gui.uploadButton, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.mainTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		}
+	})
+	panel.gui.mainTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// d for deleting selected key
+		if tcell.KeyRune == event.Key() && event.Rune() == 'd' {
+			row, _ := panel.gui.mainTable.GetSelection()
+			if row >= 1 && row <= len(panel.keys) {
+				panel.showDeleteModal(panel.keys[row-1])
+			}
+			return nil
+		}
+		return event
+	})
+}
+
+// Reloads API keys of the profile user from OCI.
+func (panel *MyCredentialsPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
+		}()
+		keys, err := panel.ociController.ListMyApiKeys()
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.keys = keys
+		panel.refreshTable()
+	}()
+}
+
+func (panel *MyCredentialsPanel) refreshTable() {
+	table := panel.gui.mainTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"FINGERPRINT", "STATE", "CREATED", "AGE DAYS", "PROFILE"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range panel.keys {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		created, age := "", ""
+		if val.ApiKey.TimeCreated != nil {
+			created = val.ApiKey.TimeCreated.Local().Format(time.RFC822)
+			age = fmt.Sprintf("%d", int(time.Since(val.ApiKey.TimeCreated.Time).Hours()/24))
+		}
+		inUse, inUseColor := "", tcell.ColorGreen
+		if val.IsMismatch() {
+			inUse, inUseColor = "FINGERPRINT MISMATCH", tcell.ColorRed
+		} else if val.IsActive {
+			inUse = "IN USE"
+		}
+		table.SetCell(row, 0, tview.NewTableCell(*val.ApiKey.Fingerprint).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(string(val.ApiKey.LifecycleState)).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 2, tview.NewTableCell(created).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 3, tview.NewTableCell(age).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 4, tview.NewTableCell(inUse).SetAlign(tview.AlignCenter).SetTextColor(inUseColor))
+	}
+}
+
+// Reads public key from file given in input and uploads it as new API key.
+func (panel *MyCredentialsPanel) upload() {
+	path := strings.TrimSpace(panel.gui.keyFileInput.GetText())
+	if path == "" {
+		panel.guiController.LogError("public key file has to be given", true)
+		return
+	}
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, path[2:])
+		}
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		panel.guiController.LogError(err.Error(), true)
+		return
+	}
+	publicKey := strings.TrimSpace(string(content))
+	if !strings.Contains(publicKey, "BEGIN PUBLIC KEY") {
+		panel.guiController.LogError(fmt.Sprintf("%s is not public key in PEM format", path), true)
+		return
+	}
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if _, err := panel.ociController.UploadMyApiKey(publicKey); err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.gui.keyFileInput.SetText("")
+		panel.reload()
+	}()
+}
+
+func (panel *MyCredentialsPanel) showDeleteModal(key oci.MyApiKey) {
+	if key.IsActive {
+		panel.guiController.LogError("key is used by active profile, switch profile to other key before deleting it", true)
+		return
+	}
+	modalName := "ModalApiKeyDelete"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to delete API key %s?", *key.ApiKey.Fingerprint)).
+		AddButtons([]string{"Delete", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			if buttonLabel != "Delete" {
+				return
+			}
+			panel.guiController.SetLoading()
+			go func() {
+				defer func() {
+					panel.guiController.RemoveLoading()
+					panel.guiController.RefreshGUI()
+				}()
+				if err := panel.ociController.DeleteMyApiKey(*key.ApiKey.Fingerprint); err != nil {
+					panel.guiController.LogError(err.Error(), true)
+					return
+				}
+				panel.reload()
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
+
+func (panel *MyCredentialsPanel) GetPanelName() string {
+	return "mycredentials"
+}
+
+func (panel *MyCredentialsPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *MyCredentialsPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *MyCredentialsPanel) GetInfo() string {
+	return "[red]Esc:[white] Exit [green]d:[white] Delete key"
+}
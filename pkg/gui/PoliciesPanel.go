@@ -0,0 +1,195 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/rivo/tview"
+)
+
+type policiesGUI struct {
+	mainGrid      *tview.Grid
+	searchInput   *tview.InputField
+	refreshButton *tview.Button
+	mainTable     *tview.Table
+}
+
+// Panel browsing IAM policies of all compartments, one statement per row,
+// with full-text search across statements grouped by compartment path.
+type PoliciesPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *policiesGUI
+	policies      []oci.PolicySummary
+	tenancyId     string
+	compartmentId string
+}
+
+func NewPoliciesPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *PoliciesPanel {
+	res := PoliciesPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newPoliciesGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewPoliciesAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewPoliciesPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newPoliciesGUI() *policiesGUI {
+	res := policiesGUI{
+		mainGrid:      tview.NewGrid(),
+		searchInput:   tview.NewInputField(),
+		refreshButton: tview.NewButton("Refresh"),
+		mainTable:     tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *PoliciesPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 60, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 30)
+	panel.gui.searchInput.SetBorder(true).SetTitle("Search statements")
+	panel.gui.mainGrid.AddItem(panel.gui.searchInput, 1, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 2, 1, 1, 0, 0, false)
+
+	panel.gui.mainTable.SetBorder(true).SetTitle("Policies Table")
+	panel.gui.mainGrid.AddItem(panel.gui.mainTable, 2, 0, 1, 4, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *PoliciesPanel) makeKeyBindings() {
+	panel.gui.searchInput.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEnter == key {
+			if panel.policies == nil {
+				panel.reload()
+			} else {
+				panel.refreshTable()
+				panel.guiController.SetFocus(panel.gui.mainTable)
+			}
+			return
+		}
+		panel.guiController.BindDefaultDoneFunc(panel.gui.refreshButton, panel.gui.refreshButton, nil)(key)
+	})
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.searchInput, panel.gui.searchInput, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.mainTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.searchInput)
+		}
+	})
+}
+
+// Reloads policies of all compartments from OCI.
+func (panel *PoliciesPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
+		}()
+		policies, err := panel.ociController.ListAllPolicies()
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.policies = policies
+		panel.refreshTable()
+	}()
+}
+
+// Returns true if statement contains all words of the query, case insensitive.
+func matchesStatement(statement string, words []string) bool {
+	lower := strings.ToLower(statement)
+	for _, word := range words {
+		if !strings.Contains(lower, word) {
+			return false
+		}
+	}
+	return true
+}
+
+func (panel *PoliciesPanel) refreshTable() {
+	table := panel.gui.mainTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	table.SetFixed(1, 0)
+
+	table.SetCell(0, 0, tview.NewTableCell("POLICY").SetAlign(tview.AlignCenter).SetSelectable(false))
+	table.SetCell(0, 1, tview.NewTableCell("STATEMENT").SetAlign(tview.AlignCenter).SetSelectable(false))
+
+	words := strings.Fields(strings.ToLower(panel.gui.searchInput.GetText()))
+	row := 1
+	lastPath := ""
+	policiesCount, statementsCount := 0, 0
+	for _, val := range panel.policies {
+		statements := make([]string, 0)
+		for _, statement := range val.Policy.Statements {
+			if matchesStatement(statement, words) {
+				statements = append(statements, statement)
+			}
+		}
+		if len(statements) == 0 {
+			continue
+		}
+		if val.CompartmentPath != lastPath {
+			table.SetCell(row, 0, tview.NewTableCell(tview.Escape(val.CompartmentPath)).SetTextColor(tcell.ColorYellow).SetSelectable(false))
+			lastPath = val.CompartmentPath
+			row += 1
+		}
+		policiesCount++
+		var cellcolor tcell.Color
+		if policiesCount%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		for idx, statement := range statements {
+			name := ""
+			if idx == 0 {
+				name = "  " + *val.Policy.Name
+			}
+			table.SetCell(row, 0, tview.NewTableCell(tview.Escape(name)).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+			table.SetCell(row, 1, tview.NewTableCell(tview.Escape(statement)).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+			statementsCount++
+			row += 1
+		}
+	}
+	table.SetTitle(fmt.Sprintf("Policies Table - %d statements in %d policies", statementsCount, policiesCount))
+	table.ScrollToBeginning()
+}
+
+func (panel *PoliciesPanel) GetPanelName() string {
+	return "policies"
+}
+
+func (panel *PoliciesPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *PoliciesPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *PoliciesPanel) GetInfo() string {
+	return "[red]Esc:[white] Exit [green]Enter in search:[white] Filter statements containing all words"
+}
@@ -0,0 +1,207 @@
+package gui
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+type regionsGUI struct {
+	mainGrid      *tview.Grid
+	refreshButton *tview.Button
+	mainTable     *tview.Table
+}
+
+// Panel listing all regions with subscription status of the tenancy.
+// Tenancy can be subscribed to new region from here.
+type RegionsPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *regionsGUI
+	regions       []oci.RegionStatus
+	tenancyId     string
+	compartmentId string
+}
+
+func NewRegionsPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *RegionsPanel {
+	res := RegionsPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newRegionsGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewRegionsAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewRegionsPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newRegionsGUI() *regionsGUI {
+	res := regionsGUI{
+		mainGrid:      tview.NewGrid(),
+		refreshButton: tview.NewButton("Refresh"),
+		mainTable:     tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *RegionsPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 30)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 1, 1, 1, 0, 0, false)
+
+	panel.gui.mainTable.SetBorder(true).SetTitle("Regions Table")
+	panel.gui.mainGrid.AddItem(panel.gui.mainTable, 2, 0, 1, 3, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *RegionsPanel) makeKeyBindings() {
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.mainTable, panel.gui.mainTable, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.mainTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		}
+	})
+	panel.gui.mainTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// s for subscribing to selected region
+		if tcell.KeyRune == event.Key() && event.Rune() == 's' {
+			row, _ := panel.gui.mainTable.GetSelection()
+			if row >= 1 && row <= len(panel.regions) {
+				panel.showSubscribeModal(panel.regions[row-1])
+			}
+			return nil
+		}
+		return event
+	})
+}
+
+// Reloads regions and subscriptions from OCI.
+func (panel *RegionsPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
+		}()
+		regions, err := panel.ociController.ListRegionStatuses()
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.regions = regions
+		panel.refreshTable()
+	}()
+}
+
+func (panel *RegionsPanel) refreshTable() {
+	table := panel.gui.mainTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"NAME", "KEY", "SUBSCRIPTION", "HOME"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range panel.regions {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		status, statusColor := "NOT SUBSCRIBED", tcell.ColorGray
+		if val.Status != "" {
+			status, statusColor = string(val.Status), tcell.ColorGreen
+			if val.Status != identity.RegionSubscriptionStatusReady {
+				statusColor = tcell.ColorYellow
+			}
+		}
+		home := ""
+		if val.IsHome {
+			home = "HOME"
+		}
+		table.SetCell(row, 0, tview.NewTableCell(*val.Region.Name).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(*val.Region.Key).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 2, tview.NewTableCell(status).SetAlign(tview.AlignCenter).SetTextColor(statusColor))
+		table.SetCell(row, 3, tview.NewTableCell(home).SetAlign(tview.AlignCenter).SetTextColor(tcell.ColorYellow))
+	}
+}
+
+func (panel *RegionsPanel) showSubscribeModal(region oci.RegionStatus) {
+	if region.Status != "" {
+		panel.guiController.LogError(fmt.Sprintf("tenancy is already subscribed to %s", *region.Region.Name), true)
+		return
+	}
+	modalName := "ModalRegionSubscribe"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to subscribe tenancy to region %s?\nSubscription can not be undone.", *region.Region.Name)).
+		AddButtons([]string{"Subscribe", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			if buttonLabel != "Subscribe" {
+				return
+			}
+			panel.guiController.SetLoading()
+			go func() {
+				defer func() {
+					panel.guiController.RemoveLoading()
+					panel.guiController.RefreshGUI()
+				}()
+				if err := panel.ociController.SubscribeRegion(*region.Region.Key); err != nil {
+					panel.guiController.LogError(err.Error(), true)
+					return
+				}
+				panel.reload()
+				panel.reloadTopPanelRegions()
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
+
+// Reloads subscribed regions of guiTopPanel dropdown.
+func (panel *RegionsPanel) reloadTopPanelRegions() {
+	regions, homeRegion, err := panel.ociController.ListSubscribedRegions()
+	if err != nil {
+		panel.guiController.LogError(err.Error(), true)
+		return
+	}
+	panel.guiController.QueueUpdateDraw(func() {
+		panel.guiController.GetGUITopPanel().SetHomeRegion(homeRegion)
+		panel.guiController.GetGUITopPanel().ReplaceRegions(&regions)
+	})
+}
+
+func (panel *RegionsPanel) GetPanelName() string {
+	return "regions"
+}
+
+func (panel *RegionsPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *RegionsPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *RegionsPanel) GetInfo() string {
+	return "[red]Esc:[white] Exit [green]s:[white] Subscribe to region"
+}
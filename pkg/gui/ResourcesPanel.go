@@ -0,0 +1,202 @@
+package gui
+
+import (
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/rivo/tview"
+)
+
+type resourcesGUI struct {
+	mainGrid       *tview.Grid
+	typeDropDown   *tview.DropDown
+	refreshButton  *tview.Button
+	mainTable      *tview.Table
+	resourcesTypes []string
+}
+
+// Panel listing volumes, boot volumes, VCNs and custom images of the compartment.
+type ResourcesPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *resourcesGUI
+	resources     []oci.ResourceSummary
+	shown         []oci.ResourceSummary
+	tenancyId     string
+	compartmentId string
+}
+
+func NewResourcesPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *ResourcesPanel {
+	res := ResourcesPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newResourcesGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewResourcesAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewResourcesPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newResourcesGUI() *resourcesGUI {
+	res := resourcesGUI{
+		mainGrid:       tview.NewGrid(),
+		typeDropDown:   tview.NewDropDown(),
+		refreshButton:  tview.NewButton("Refresh"),
+		mainTable:      tview.NewTable(),
+		resourcesTypes: []string{"ALL", oci.ResourceBootVolume, oci.ResourceImage, oci.ResourceVcn, oci.ResourceVolume},
+	}
+	return &res
+}
+
+func (panel *ResourcesPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 20, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 30)
+	panel.gui.typeDropDown.SetBorder(true).SetTitle("Type")
+	panel.gui.mainGrid.AddItem(panel.gui.typeDropDown, 1, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 2, 1, 1, 0, 0, false)
+
+	panel.gui.mainTable.SetBorder(true).SetTitle("Compartment Resources Table")
+	panel.gui.mainGrid.AddItem(panel.gui.mainTable, 2, 0, 1, 4, 0, 0, false)
+
+	panel.gui.typeDropDown.SetOptions(panel.gui.resourcesTypes, func(text string, index int) {
+		if panel.resources != nil {
+			panel.refreshTable()
+		}
+	})
+	panel.gui.typeDropDown.SetCurrentOption(0)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *ResourcesPanel) makeKeyBindings() {
+	panel.gui.typeDropDown.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.refreshButton, panel.gui.refreshButton, nil))
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.typeDropDown, panel.gui.typeDropDown, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.mainTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		}
+	})
+	panel.gui.mainTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// v for moving resource to other compartment
+		if tcell.KeyRune == event.Key() && event.Rune() == 'v' {
+			if resource := panel.getSelectedResource(); resource != nil {
+				panel.showMovePanel(*resource)
+			}
+		}
+		return event
+	})
+}
+
+// Reloads all resources of the compartment from OCI.
+func (panel *ResourcesPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
+		}()
+		resources, err := panel.ociController.ListCompartmentResources(panel.compartmentId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		sort.SliceStable(resources, func(i, j int) bool {
+			if resources[i].Type != resources[j].Type {
+				return resources[i].Type < resources[j].Type
+			}
+			return resources[i].Name < resources[j].Name
+		})
+		panel.resources = resources
+		panel.refreshTable()
+	}()
+}
+
+func (panel *ResourcesPanel) refreshTable() {
+	panel.gui.mainTable.Clear()
+	panel.gui.mainTable.SetSelectable(true, false).SetBorders(false)
+
+	// header
+	panel.gui.mainTable.SetCell(0, 0, tview.NewTableCell("TYPE").SetAlign(tview.AlignCenter).SetSelectable(false))
+	panel.gui.mainTable.SetCell(0, 1, tview.NewTableCell("NAME").SetAlign(tview.AlignCenter).SetSelectable(false))
+	panel.gui.mainTable.SetCell(0, 2, tview.NewTableCell("CREATION TIME").SetAlign(tview.AlignCenter).SetSelectable(false))
+	panel.gui.mainTable.SetCell(0, 3, tview.NewTableCell("LIFECYCLE STATE").SetAlign(tview.AlignCenter).SetSelectable(false))
+	panel.gui.mainTable.SetCell(0, 4, tview.NewTableCell("OCID").SetAlign(tview.AlignCenter).SetSelectable(false))
+
+	_, selType := panel.gui.typeDropDown.GetCurrentOption()
+	panel.shown = make([]oci.ResourceSummary, 0)
+	for _, res := range panel.resources {
+		if selType == "ALL" || selType == res.Type {
+			panel.shown = append(panel.shown, res)
+		}
+	}
+
+	for row, val := range panel.shown {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		panel.gui.mainTable.SetCell(row, 0, tview.NewTableCell(val.Type).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		panel.gui.mainTable.SetCell(row, 1, tview.NewTableCell(val.Name).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		panel.gui.mainTable.SetCell(row, 2, tview.NewTableCell(val.TimeCreated.UTC().String()).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		panel.gui.mainTable.SetCell(row, 3, tview.NewTableCell(val.LifecycleState).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		panel.gui.mainTable.SetCell(row, 4, tview.NewTableCell(val.Id).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+	}
+}
+
+func (panel *ResourcesPanel) getSelectedResource() *oci.ResourceSummary {
+	row, _ := panel.gui.mainTable.GetSelection()
+	if row < 1 || row > len(panel.shown) {
+		return nil
+	}
+	return &panel.shown[row-1]
+}
+
+func (panel *ResourcesPanel) showMovePanel(resource oci.ResourceSummary) {
+	movePanel := NewMoveResourcePanel(panel.guiController, panel.ociController, panel.tenancyId, resource)
+	movePanel.SetCloseFunc(func(moved bool) {
+		panel.guiController.RemovePage(movePanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+		if moved {
+			panel.reload()
+		}
+	})
+	panel.guiController.AddPage(movePanel.GetPanelName(), movePanel.GetGUI(), true)
+	panel.guiController.SetFocus(movePanel.GetFocusPrimitive())
+}
+
+func (panel *ResourcesPanel) GetPanelName() string {
+	return "resources"
+}
+
+func (panel *ResourcesPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *ResourcesPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *ResourcesPanel) GetInfo() string {
+	return "[red]Esc:[white] Exit [green]v:[white] Move to compartment"
+}
@@ -0,0 +1,20 @@
+//go:build !(aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos)
+// +build !aix,!darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris,!zos
+
+package gui
+
+import (
+	"errors"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Creates default screen of the platform.
+func NewScreen() (tcell.Screen, error) {
+	return tcell.NewScreen()
+}
+
+// Copying needs terminal with OSC 52 support, console screen has none.
+func copyToClipboard(text string) error {
+	return errors.New("copying to clipboard is not supported on this platform")
+}
@@ -0,0 +1,48 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris zos
+
+package gui
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// Terminal of the screen, writes are serialized so escape sequences written
+// next to screen updates are never interleaved with them.
+type screenTty struct {
+	tcell.Tty
+	mu sync.Mutex
+}
+
+func (tty *screenTty) Write(b []byte) (int, error) {
+	tty.mu.Lock()
+	defer tty.mu.Unlock()
+	return tty.Tty.Write(b)
+}
+
+var clipboardTty *screenTty
+
+// Creates terminal screen, its terminal is shared with copyToClipboard.
+func NewScreen() (tcell.Screen, error) {
+	tty, err := tcell.NewDevTty()
+	if err != nil {
+		return nil, err
+	}
+	clipboardTty = &screenTty{Tty: tty}
+	return tcell.NewTerminfoScreenFromTty(clipboardTty)
+}
+
+// Copies text to system clipboard using OSC 52 sequence written to the screen terminal.
+// Works only in terminals supporting OSC 52 (e.g. iTerm2, kitty, alacritty, xterm).
+func copyToClipboard(text string) error {
+	if clipboardTty == nil {
+		return errors.New("screen was not created by NewScreen")
+	}
+	_, err := fmt.Fprintf(clipboardTty, "\x1b]52;c;%s\a", base64.StdEncoding.EncodeToString([]byte(text)))
+	return err
+}
@@ -0,0 +1,111 @@
+package gui
+
+import (
+	"strings"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+// Panel creating tag namespace in compartment or tag definition in namespace.
+type TagCreatePanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	compartmentId string
+	namespace     *identity.TagNamespaceSummary
+	grid          *tview.Grid
+	form          *tview.Form
+	nameInput     *tview.InputField
+	descInput     *tview.InputField
+	costCheck     *tview.Checkbox
+	enumInput     *tview.InputField
+	closeFunc     func(created bool)
+}
+
+// Creates panel for new tag namespace if Namespace is nil, otherwise for new tag in Namespace.
+func NewTagCreatePanel(GuiController *GuiController, OciController *oci.OCIController, CompartmentId string, Namespace *identity.TagNamespaceSummary) *TagCreatePanel {
+	res := TagCreatePanel{
+		guiController: GuiController,
+		ociController: OciController,
+		compartmentId: CompartmentId,
+		namespace:     Namespace,
+		grid:          tview.NewGrid(),
+		form:          tview.NewForm(),
+		nameInput:     tview.NewInputField().SetLabel("Name:").SetFieldWidth(40),
+		descInput:     tview.NewInputField().SetLabel("Description:").SetFieldWidth(60),
+		costCheck:     tview.NewCheckbox().SetLabel("Cost tracking:"),
+		enumInput:     tview.NewInputField().SetLabel("Allowed values (a,b,c):").SetFieldWidth(60),
+		closeFunc:     func(created bool) {},
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *TagCreatePanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *TagCreatePanel) GetPanelName() string {
+	return "TagCreatePanel"
+}
+
+func (panel *TagCreatePanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+// Function called when panel is closed, created is true if namespace or tag was created.
+func (panel *TagCreatePanel) SetCloseFunc(close func(created bool)) {
+	panel.closeFunc = close
+}
+
+func (panel *TagCreatePanel) createGUI() {
+	title := "New Tag Namespace"
+	panel.form.AddFormItem(panel.nameInput).AddFormItem(panel.descInput)
+	if panel.namespace != nil {
+		title = "New Tag in " + *panel.namespace.Name
+		panel.form.AddFormItem(panel.costCheck).AddFormItem(panel.enumInput)
+	}
+	panel.form.AddButton("Create", panel.create).
+		AddButton("Cancel", func() { panel.closeFunc(false) })
+	panel.form.SetCancelFunc(func() { panel.closeFunc(false) })
+	panel.form.SetBorder(true).SetTitle(title)
+
+	panel.grid.SetColumns(0, 100, 0)
+	panel.grid.SetRows(0, 13, 0)
+	panel.grid.AddItem(panel.form, 1, 1, 1, 1, 0, 0, true)
+}
+
+func (panel *TagCreatePanel) create() {
+	name := strings.TrimSpace(panel.nameInput.GetText())
+	description := strings.TrimSpace(panel.descInput.GetText())
+	if name == "" || description == "" {
+		panel.guiController.LogErrorOnPage("name and description are required", panel.GetPanelName(), panel.form)
+		return
+	}
+	values := make([]string, 0)
+	for _, value := range strings.Split(panel.enumInput.GetText(), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	costTracking := panel.costCheck.IsChecked()
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		var err error
+		if panel.namespace == nil {
+			_, err = panel.ociController.CreateTagNamespace(panel.compartmentId, name, description)
+		} else {
+			_, err = panel.ociController.CreateTag(*panel.namespace.Id, name, description, costTracking, values)
+		}
+		if err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+			return
+		}
+		panel.closeFunc(true)
+	}()
+}
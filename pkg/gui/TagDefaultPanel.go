@@ -0,0 +1,122 @@
+package gui
+
+import (
+	"strings"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+// Panel creating tag default for tag of the namespace in compartment.
+type TagDefaultPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	compartmentId string
+	namespace     *identity.TagNamespaceSummary
+	tags          []identity.Tag
+	grid          *tview.Grid
+	form          *tview.Form
+	tagSelect     *tview.DropDown
+	valueInput    *tview.InputField
+	requiredCheck *tview.Checkbox
+	closeFunc     func(created bool)
+}
+
+// Creates panel for new tag default, only active tags of Namespace are offered.
+func NewTagDefaultPanel(GuiController *GuiController, OciController *oci.OCIController, CompartmentId string, Namespace *identity.TagNamespaceSummary, Tags []identity.Tag) *TagDefaultPanel {
+	res := TagDefaultPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		compartmentId: CompartmentId,
+		namespace:     Namespace,
+		tags:          make([]identity.Tag, 0),
+		grid:          tview.NewGrid(),
+		form:          tview.NewForm(),
+		tagSelect:     tview.NewDropDown().SetLabel("Tag:"),
+		valueInput:    tview.NewInputField().SetLabel("Value:").SetFieldWidth(60),
+		requiredCheck: tview.NewCheckbox().SetLabel("Required:"),
+		closeFunc:     func(created bool) {},
+	}
+	for _, tag := range Tags {
+		if tag.IsRetired == nil || !*tag.IsRetired {
+			res.tags = append(res.tags, tag)
+		}
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *TagDefaultPanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *TagDefaultPanel) GetPanelName() string {
+	return "TagDefaultPanel"
+}
+
+func (panel *TagDefaultPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+// Function called when panel is closed, created is true if tag default was created.
+func (panel *TagDefaultPanel) SetCloseFunc(close func(created bool)) {
+	panel.closeFunc = close
+}
+
+func (panel *TagDefaultPanel) createGUI() {
+	names := make([]string, 0)
+	for _, tag := range panel.tags {
+		names = append(names, *tag.Name)
+	}
+	panel.tagSelect.SetOptions(names, func(text string, index int) {
+		label := "Value:"
+		if values, ok := oci.TagEnumValues(panel.tags[index]); ok {
+			label = "Value (" + strings.Join(values, ",") + "):"
+		}
+		panel.valueInput.SetLabel(label)
+	})
+	if len(names) > 0 {
+		panel.tagSelect.SetCurrentOption(0)
+	}
+
+	panel.form.AddFormItem(panel.tagSelect).
+		AddFormItem(panel.valueInput).
+		AddFormItem(panel.requiredCheck).
+		AddButton("Create", panel.create).
+		AddButton("Cancel", func() { panel.closeFunc(false) })
+	panel.form.SetCancelFunc(func() { panel.closeFunc(false) })
+	panel.form.SetBorder(true).SetTitle("New Tag Default for " + *panel.namespace.Name)
+
+	panel.grid.SetColumns(0, 100, 0)
+	panel.grid.SetRows(0, 11, 0)
+	panel.grid.AddItem(panel.form, 1, 1, 1, 1, 0, 0, true)
+}
+
+func (panel *TagDefaultPanel) create() {
+	index, _ := panel.tagSelect.GetCurrentOption()
+	if index < 0 {
+		panel.guiController.LogErrorOnPage("active tag has to be selected", panel.GetPanelName(), panel.form)
+		return
+	}
+	tag := panel.tags[index]
+	value := strings.TrimSpace(panel.valueInput.GetText())
+	required := panel.requiredCheck.IsChecked()
+	// required tag default may be left without value, user provides it on resource creation
+	if value == "" && !required {
+		panel.guiController.LogErrorOnPage("value is required", panel.GetPanelName(), panel.form)
+		return
+	}
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.RefreshGUI()
+		}()
+		if _, err := panel.ociController.CreateTagDefault(panel.compartmentId, *tag.Id, value, required); err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+			return
+		}
+		panel.closeFunc(true)
+	}()
+}
@@ -0,0 +1,242 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/rivo/tview"
+)
+
+// Makes free and defined tag tables editable, a: add, e: edit, d: delete.
+// Every change is persisted with save function receiving complete set of tags,
+// defined tag values are validated against their tag definitions before saving.
+type TagEditor struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	freeTable     *tview.Table
+	definedTable  *tview.Table
+	pageName      string
+	freeTags      map[string]string
+	definedTags   map[string]map[string]interface{}
+	saveFunc      func(freeTags map[string]string, definedTags map[string]map[string]interface{}) error
+}
+
+// Creates editor of tables shown on page PageName.
+func NewTagEditor(GuiController *GuiController, OciController *oci.OCIController, PageName string,
+	FreeTable *tview.Table, DefinedTable *tview.Table,
+	FreeTags map[string]string, DefinedTags map[string]map[string]interface{},
+	Save func(freeTags map[string]string, definedTags map[string]map[string]interface{}) error) *TagEditor {
+	res := TagEditor{
+		guiController: GuiController,
+		ociController: OciController,
+		freeTable:     FreeTable,
+		definedTable:  DefinedTable,
+		pageName:      PageName,
+		freeTags:      copyFreeTags(FreeTags),
+		definedTags:   copyDefinedTags(DefinedTags),
+		saveFunc:      Save,
+	}
+	res.makeKeyBindings()
+	return &res
+}
+
+func copyFreeTags(tags map[string]string) map[string]string {
+	res := make(map[string]string)
+	for k, v := range tags {
+		res[k] = v
+	}
+	return res
+}
+
+func copyDefinedTags(tags map[string]map[string]interface{}) map[string]map[string]interface{} {
+	res := make(map[string]map[string]interface{})
+	for ns, ts := range tags {
+		res[ns] = make(map[string]interface{})
+		for k, v := range ts {
+			res[ns][k] = v
+		}
+	}
+	return res
+}
+
+func (editor *TagEditor) makeKeyBindings() {
+	editor.freeTable.SetSelectable(true, false)
+	editor.definedTable.SetSelectable(true, false)
+	editor.freeTable.SetTitle("Free Tags (a: add, e: edit, d: delete)")
+	editor.definedTable.SetTitle("Defined Tags (a: add, e: edit, d: delete)")
+	editor.freeTable.SetInputCapture(editor.getInputCapture(editor.freeTable, false))
+	editor.definedTable.SetInputCapture(editor.getInputCapture(editor.definedTable, true))
+}
+
+func (editor *TagEditor) getInputCapture(table *tview.Table, defined bool) func(event *tcell.EventKey) *tcell.EventKey {
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		if tcell.KeyRune != event.Key() {
+			return event
+		}
+		switch event.Rune() {
+		case 'a':
+			editor.showEditForm(table, defined, "")
+			return nil
+		case 'e':
+			if key := editor.getSelectedKey(table); key != "" {
+				editor.showEditForm(table, defined, key)
+			}
+			return nil
+		case 'd':
+			if key := editor.getSelectedKey(table); key != "" {
+				editor.confirmDelete(table, defined, key)
+			}
+			return nil
+		}
+		return event
+	}
+}
+
+func (editor *TagEditor) getSelectedKey(table *tview.Table) string {
+	row, _ := table.GetSelection()
+	if row < 1 || row >= table.GetRowCount() {
+		return ""
+	}
+	if key, ok := table.GetCell(row, 0).GetReference().(string); ok {
+		return key
+	}
+	return ""
+}
+
+// Shows form adding tag if key is empty, otherwise editing tag with the key.
+func (editor *TagEditor) showEditForm(table *tview.Table, defined bool, key string) {
+	formName := "TagEditForm"
+	form := tview.NewForm()
+	keyLabel := "Key:"
+	if defined {
+		keyLabel = "Namespace.Key:"
+	}
+	keyInput := tview.NewInputField().SetLabel(keyLabel).SetFieldWidth(50).SetText(key)
+	valueInput := tview.NewInputField().SetLabel("Value:").SetFieldWidth(50)
+	if key != "" {
+		if defined {
+			ns, k, _ := strings.Cut(key, ".")
+			valueInput.SetText(fmt.Sprintf("%v", editor.definedTags[ns][k]))
+		} else {
+			valueInput.SetText(editor.freeTags[key])
+		}
+	}
+	closeForm := func() {
+		editor.guiController.RemovePage(formName, editor.pageName)
+		editor.guiController.SetFocus(table)
+	}
+	save := func() {
+		newKey := strings.TrimSpace(keyInput.GetText())
+		value := valueInput.GetText()
+		if newKey == "" {
+			editor.guiController.LogErrorOnPage("tag key can not be empty", formName, form)
+			return
+		}
+		freeTags := copyFreeTags(editor.freeTags)
+		definedTags := copyDefinedTags(editor.definedTags)
+		var ns, k string
+		if defined {
+			var found bool
+			ns, k, found = strings.Cut(newKey, ".")
+			if !found || ns == "" || k == "" {
+				editor.guiController.LogErrorOnPage("defined tag has to be written as namespace.key", formName, form)
+				return
+			}
+			if key != "" {
+				oldNs, oldK, _ := strings.Cut(key, ".")
+				delete(definedTags[oldNs], oldK)
+			}
+			if definedTags[ns] == nil {
+				definedTags[ns] = make(map[string]interface{})
+			}
+			definedTags[ns][k] = value
+		} else {
+			if key != "" {
+				delete(freeTags, key)
+			}
+			freeTags[newKey] = value
+		}
+		editor.guiController.SetLoading()
+		go func() {
+			defer func() {
+				editor.guiController.RemoveLoading()
+				editor.guiController.RefreshGUI()
+			}()
+			if defined {
+				if err := editor.ociController.ValidateDefinedTag(ns, k, value); err != nil {
+					editor.guiController.LogErrorOnPage(err.Error(), formName, form)
+					return
+				}
+			}
+			if err := editor.save(freeTags, definedTags); err != nil {
+				editor.guiController.LogErrorOnPage(err.Error(), formName, form)
+				return
+			}
+			closeForm()
+		}()
+	}
+	form.AddFormItem(keyInput).
+		AddFormItem(valueInput).
+		AddButton("Save", save).
+		AddButton("Cancel", closeForm)
+	form.SetCancelFunc(closeForm)
+	title := "Add Tag"
+	if key != "" {
+		title = "Edit Tag"
+	}
+	form.SetBorder(true).SetTitle(title)
+	grid := tview.NewGrid().SetColumns(0, 80, 0).SetRows(0, 9, 0)
+	grid.AddItem(form, 1, 1, 1, 1, 0, 0, true)
+	editor.guiController.AddPage(formName, grid, true)
+	editor.guiController.SetFocus(form)
+}
+
+func (editor *TagEditor) confirmDelete(table *tview.Table, defined bool, key string) {
+	modalName := "ModalTagEditor"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to remove tag %s?", key)).
+		AddButtons([]string{"Remove", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			editor.guiController.RemovePage(modalName, editor.pageName)
+			editor.guiController.SetFocus(table)
+			if buttonLabel != "Remove" {
+				return
+			}
+			freeTags := copyFreeTags(editor.freeTags)
+			definedTags := copyDefinedTags(editor.definedTags)
+			if defined {
+				ns, k, _ := strings.Cut(key, ".")
+				delete(definedTags[ns], k)
+				if len(definedTags[ns]) == 0 {
+					delete(definedTags, ns)
+				}
+			} else {
+				delete(freeTags, key)
+			}
+			editor.guiController.SetLoading()
+			go func() {
+				defer func() {
+					editor.guiController.RemoveLoading()
+					editor.guiController.RefreshGUI()
+				}()
+				if err := editor.save(freeTags, definedTags); err != nil {
+					editor.guiController.LogErrorOnPage(err.Error(), editor.pageName, table)
+				}
+			}()
+		})
+	editor.guiController.AddPage(modalName, modal, false)
+}
+
+// Persists tags and refreshes both tables on success.
+func (editor *TagEditor) save(freeTags map[string]string, definedTags map[string]map[string]interface{}) error {
+	if err := editor.saveFunc(freeTags, definedTags); err != nil {
+		return err
+	}
+	editor.freeTags = freeTags
+	editor.definedTags = definedTags
+	fillFreeTagTable(editor.freeTable, freeTags)
+	fillDefinedTagTable(editor.definedTable, definedTags)
+	return nil
+}
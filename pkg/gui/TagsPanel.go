@@ -0,0 +1,419 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+type tagsGUI struct {
+	mainGrid        *tview.Grid
+	refreshButton   *tview.Button
+	namespacesTable *tview.Table
+	tagsTable       *tview.Table
+	defaultsTable   *tview.Table
+}
+
+// Panel browsing tag namespaces, tag definitions and tag defaults of the compartment.
+// Namespaces and tags can be created and retired, tag defaults created and deleted.
+type TagsPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *tagsGUI
+	namespaces    []identity.TagNamespaceSummary
+	tags          []identity.Tag
+	defaults      []identity.TagDefaultSummary
+	namespace     *identity.TagNamespaceSummary
+	costTracking  map[string]bool
+	tenancyId     string
+	compartmentId string
+}
+
+func NewTagsPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *TagsPanel {
+	res := TagsPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newTagsGUI(),
+		costTracking:  make(map[string]bool),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewTagsAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewTagsPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newTagsGUI() *tagsGUI {
+	res := tagsGUI{
+		mainGrid:        tview.NewGrid(),
+		refreshButton:   tview.NewButton("Refresh"),
+		namespacesTable: tview.NewTable(),
+		tagsTable:       tview.NewTable(),
+		defaultsTable:   tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *TagsPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 18, 12)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 0, 1, 2, 0, 0, false)
+
+	panel.gui.namespacesTable.SetBorder(true).SetTitle("Tag Namespaces (n: new, x: retire/reactivate)")
+	panel.gui.tagsTable.SetBorder(true).SetTitle("Tags (n: new, x: retire/reactivate)")
+	panel.gui.defaultsTable.SetBorder(true).SetTitle("Tag Defaults (n: new for selected namespace, d: delete)")
+	panel.gui.mainGrid.AddItem(panel.gui.namespacesTable, 2, 0, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(panel.gui.tagsTable, 2, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(panel.gui.defaultsTable, 3, 0, 1, 2, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *TagsPanel) makeKeyBindings() {
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.namespacesTable, panel.gui.namespacesTable, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	cycle := []*tview.Table{panel.gui.namespacesTable, panel.gui.tagsTable, panel.gui.defaultsTable}
+	for idx, table := range cycle {
+		next := cycle[(idx+1)%len(cycle)]
+		table.SetDoneFunc(func(key tcell.Key) {
+			if tcell.KeyTab == key {
+				panel.guiController.SetFocus(next)
+			}
+			if tcell.KeyEscape == key {
+				panel.guiController.SetFocus(panel.gui.refreshButton)
+			}
+		})
+	}
+
+	panel.gui.namespacesTable.SetSelectedFunc(func(row, column int) {
+		if row < 1 || row > len(panel.namespaces) {
+			return
+		}
+		panel.loadTags(&panel.namespaces[row-1])
+	})
+	panel.gui.namespacesTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if tcell.KeyRune != event.Key() {
+			return event
+		}
+		switch event.Rune() {
+		// n for new namespace
+		case 'n':
+			panel.showCreatePanel(nil, panel.gui.namespacesTable)
+			return nil
+		// x for retiring or reactivating namespace
+		case 'x':
+			row, _ := panel.gui.namespacesTable.GetSelection()
+			if row >= 1 && row <= len(panel.namespaces) {
+				namespace := panel.namespaces[row-1]
+				retired := namespace.IsRetired != nil && *namespace.IsRetired
+				panel.confirmRetire("namespace "+*namespace.Name, retired, panel.gui.namespacesTable, func() error {
+					return panel.ociController.SetTagNamespaceRetired(*namespace.Id, !retired)
+				})
+			}
+			return nil
+		}
+		return event
+	})
+	panel.gui.tagsTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if tcell.KeyRune != event.Key() || panel.namespace == nil {
+			return event
+		}
+		switch event.Rune() {
+		// n for new tag in selected namespace
+		case 'n':
+			panel.showCreatePanel(panel.namespace, panel.gui.tagsTable)
+			return nil
+		// x for retiring or reactivating tag
+		case 'x':
+			row, _ := panel.gui.tagsTable.GetSelection()
+			if row >= 1 && row <= len(panel.tags) {
+				tag := panel.tags[row-1]
+				retired := tag.IsRetired != nil && *tag.IsRetired
+				panel.confirmRetire("tag "+*panel.namespace.Name+"."+*tag.Name, retired, panel.gui.tagsTable, func() error {
+					return panel.ociController.SetTagRetired(*tag.TagNamespaceId, *tag.Name, !retired)
+				})
+			}
+			return nil
+		}
+		return event
+	})
+	panel.gui.defaultsTable.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if tcell.KeyRune != event.Key() {
+			return event
+		}
+		switch event.Rune() {
+		// n for new tag default of tag in selected namespace
+		case 'n':
+			if panel.namespace == nil {
+				panel.guiController.LogError("select tag namespace first", true)
+				return nil
+			}
+			panel.showDefaultPanel()
+			return nil
+		// d for deleting tag default
+		case 'd':
+			row, _ := panel.gui.defaultsTable.GetSelection()
+			if row >= 1 && row <= len(panel.defaults) {
+				tagDefault := panel.defaults[row-1]
+				text := fmt.Sprintf("Do you want to delete tag default of %s?", *tagDefault.TagDefinitionName)
+				panel.confirm(text, "Delete", panel.gui.defaultsTable, func() error {
+					return panel.ociController.DeleteTagDefault(*tagDefault.Id)
+				})
+			}
+			return nil
+		}
+		return event
+	})
+}
+
+// Reloads namespaces, cost-tracking tags and tag defaults from OCI.
+func (panel *TagsPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.namespacesTable)
+			panel.guiController.RefreshGUI()
+		}()
+		namespaces, err := panel.ociController.ListTagNamespaces(panel.compartmentId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		costTags, err := panel.ociController.ListCostTrackingTags()
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		defaults, err := panel.ociController.ListTagDefaults(panel.compartmentId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.costTracking = make(map[string]bool)
+		for _, tag := range costTags {
+			panel.costTracking[*tag.TagNamespaceId] = true
+			panel.costTracking[*tag.Id] = true
+		}
+		panel.namespaces = namespaces
+		panel.refreshNamespaces()
+		panel.refreshDefaults(defaults)
+		// selected namespace is looked up again, it points to replaced slice
+		if panel.namespace != nil {
+			panel.reselectNamespace(*panel.namespace.Id)
+		}
+	}()
+}
+
+func (panel *TagsPanel) reselectNamespace(namespaceId string) {
+	for idx := range panel.namespaces {
+		if *panel.namespaces[idx].Id == namespaceId {
+			panel.loadTags(&panel.namespaces[idx])
+			return
+		}
+	}
+	// namespace is gone, e.g. after compartment change
+	panel.namespace = nil
+	panel.tags = nil
+	panel.gui.tagsTable.Clear()
+	panel.gui.tagsTable.SetTitle("Tags (n: new, x: retire/reactivate)")
+}
+
+func retiredToString(retired *bool) (string, tcell.Color) {
+	if retired != nil && *retired {
+		return "RETIRED", tcell.ColorGray
+	}
+	return "ACTIVE", tcell.ColorGreen
+}
+
+func (panel *TagsPanel) refreshNamespaces() {
+	table := panel.gui.namespacesTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"NAME", "STATE", "COST TRACKING", "DESCRIPTION"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range panel.namespaces {
+		row += 1
+		state, color := retiredToString(val.IsRetired)
+		cost := ""
+		if panel.costTracking[*val.Id] {
+			cost = "YES"
+		}
+		table.SetCell(row, 0, tview.NewTableCell(*val.Name).SetAlign(tview.AlignLeft))
+		table.SetCell(row, 1, tview.NewTableCell(state).SetAlign(tview.AlignCenter).SetTextColor(color))
+		table.SetCell(row, 2, tview.NewTableCell(cost).SetAlign(tview.AlignCenter).SetTextColor(tcell.ColorYellow))
+		table.SetCell(row, 3, tview.NewTableCell(tview.Escape(oci.StringOrEmpty(val.Description))).SetAlign(tview.AlignLeft).SetMaxWidth(40))
+	}
+}
+
+func (panel *TagsPanel) refreshDefaults(defaults []identity.TagDefaultSummary) {
+	panel.defaults = defaults
+	names := make(map[string]string)
+	for _, namespace := range panel.namespaces {
+		names[*namespace.Id] = *namespace.Name
+	}
+	table := panel.gui.defaultsTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"TAG", "VALUE", "REQUIRED", "STATE"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range defaults {
+		row += 1
+		namespace, ok := names[*val.TagNamespaceId]
+		if !ok {
+			namespace = *val.TagNamespaceId
+		}
+		required := "NO"
+		if val.IsRequired != nil && *val.IsRequired {
+			required = "YES"
+		}
+		table.SetCell(row, 0, tview.NewTableCell(namespace+"."+*val.TagDefinitionName).SetAlign(tview.AlignLeft))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(oci.StringOrEmpty(val.Value))).SetAlign(tview.AlignLeft))
+		table.SetCell(row, 2, tview.NewTableCell(required).SetAlign(tview.AlignCenter))
+		table.SetCell(row, 3, tview.NewTableCell(string(val.LifecycleState)).SetAlign(tview.AlignCenter))
+	}
+	if len(defaults) == 0 {
+		table.SetCell(1, 0, tview.NewTableCell("No tag defaults.").SetSelectable(false))
+	}
+}
+
+// Loads tag definitions of the namespace into tags table.
+func (panel *TagsPanel) loadTags(namespace *identity.TagNamespaceSummary) {
+	panel.namespace = namespace
+	panel.gui.tagsTable.Clear()
+	panel.gui.tagsTable.SetCell(0, 0, tview.NewTableCell("Loading ...").SetSelectable(false))
+	go func() {
+		defer panel.guiController.RefreshGUI()
+		tags, err := panel.ociController.ListTagDefinitions(*namespace.Id)
+		if err != nil {
+			panel.tags = nil
+			panel.gui.tagsTable.Clear()
+			panel.gui.tagsTable.SetCell(0, 0, tview.NewTableCell("[red]"+tview.Escape(err.Error())).SetSelectable(false))
+			return
+		}
+		panel.tags = tags
+		panel.refreshTags()
+	}()
+}
+
+func (panel *TagsPanel) refreshTags() {
+	table := panel.gui.tagsTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	table.SetTitle(fmt.Sprintf("Tags of %s (n: new, x: retire/reactivate)", *panel.namespace.Name))
+	for col, header := range []string{"NAME", "STATE", "COST TRACKING", "VALUES"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range panel.tags {
+		row += 1
+		state, color := retiredToString(val.IsRetired)
+		cost := ""
+		if (val.IsCostTracking != nil && *val.IsCostTracking) || panel.costTracking[*val.Id] {
+			cost = "YES"
+		}
+		values := "any"
+		if enum, ok := oci.TagEnumValues(val); ok {
+			values = strings.Join(enum, ",")
+		}
+		table.SetCell(row, 0, tview.NewTableCell(*val.Name).SetAlign(tview.AlignLeft))
+		table.SetCell(row, 1, tview.NewTableCell(state).SetAlign(tview.AlignCenter).SetTextColor(color))
+		table.SetCell(row, 2, tview.NewTableCell(cost).SetAlign(tview.AlignCenter).SetTextColor(tcell.ColorYellow))
+		table.SetCell(row, 3, tview.NewTableCell(tview.Escape(values)).SetAlign(tview.AlignLeft).SetMaxWidth(40))
+	}
+	if len(panel.tags) == 0 {
+		table.SetCell(1, 0, tview.NewTableCell("No tags.").SetSelectable(false))
+	}
+}
+
+func (panel *TagsPanel) showCreatePanel(namespace *identity.TagNamespaceSummary, returnFocus tview.Primitive) {
+	createPanel := NewTagCreatePanel(panel.guiController, panel.ociController, panel.compartmentId, namespace)
+	createPanel.SetCloseFunc(func(created bool) {
+		panel.guiController.RemovePage(createPanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(returnFocus)
+		if created {
+			panel.reload()
+		}
+	})
+	panel.guiController.AddPage(createPanel.GetPanelName(), createPanel.GetGUI(), true)
+	panel.guiController.SetFocus(createPanel.GetFocusPrimitive())
+}
+
+func (panel *TagsPanel) showDefaultPanel() {
+	defaultPanel := NewTagDefaultPanel(panel.guiController, panel.ociController, panel.compartmentId, panel.namespace, panel.tags)
+	defaultPanel.SetCloseFunc(func(created bool) {
+		panel.guiController.RemovePage(defaultPanel.GetPanelName(), n_main)
+		panel.guiController.SetFocus(panel.gui.defaultsTable)
+		if created {
+			panel.reload()
+		}
+	})
+	panel.guiController.AddPage(defaultPanel.GetPanelName(), defaultPanel.GetGUI(), true)
+	panel.guiController.SetFocus(defaultPanel.GetFocusPrimitive())
+}
+
+func (panel *TagsPanel) confirmRetire(what string, retired bool, returnFocus tview.Primitive, apply func() error) {
+	action := "Retire"
+	if retired {
+		action = "Reactivate"
+	}
+	panel.confirm(fmt.Sprintf("Do you want to %s %s?", strings.ToLower(action), what), action, returnFocus, apply)
+}
+
+// Asks for confirmation, apply is called in background and panel is reloaded on success.
+func (panel *TagsPanel) confirm(text string, action string, returnFocus tview.Primitive, apply func() error) {
+	modalName := "ModalTagsPanel"
+	modal := tview.NewModal().
+		SetText(text).
+		AddButtons([]string{action, "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, n_main)
+			panel.guiController.SetFocus(returnFocus)
+			if buttonLabel != action {
+				return
+			}
+			go func() {
+				if err := apply(); err != nil {
+					panel.guiController.LogError(err.Error(), true)
+					panel.guiController.RefreshGUI()
+					return
+				}
+				panel.reload()
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
+
+func (panel *TagsPanel) GetPanelName() string {
+	return "tags"
+}
+
+func (panel *TagsPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *TagsPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *TagsPanel) GetInfo() string {
+	return "[red]Enter:[white] Tags of namespace [red]Esc:[white] Exit [green]Tab:[white] Next table [green]n:[white] New [green]x:[white] Retire/Reactivate [green]d:[white] Delete default"
+}
@@ -20,6 +20,7 @@ type guiTopPanel struct {
 	compartmentsMu sync.Mutex
 
 	defaultRegion string
+	homeRegion    string
 
 	guiPrimitve *tview.Grid
 
@@ -30,6 +31,11 @@ func (panel *guiTopPanel) SetDefaultRegion(region string) {
 	panel.defaultRegion = region
 }
 
+// Sets name of tenancy home region, it is marked in regions dropdown.
+func (panel *guiTopPanel) SetHomeRegion(region string) {
+	panel.homeRegion = region
+}
+
 func (panel *guiTopPanel) GetProfileInput() *tview.InputField {
 	return panel.profileInput
 }
@@ -64,6 +70,38 @@ func (panel *guiTopPanel) UpdateCompartments(compartments *[]identity.Compartmen
 	panel.toBeRefreshed = true
 }
 
+// Replaces regions in dropdown keeping currently selected region selected.
+// Has to be called from GUI thread.
+func (panel *guiTopPanel) ReplaceRegions(regions *[]identity.Region) {
+	selected := panel.GetSelectedRegionName()
+	panel.regionsMu.Lock()
+	panel.regions = regions
+	panel.regionsMu.Unlock()
+	defaultRegion := panel.defaultRegion
+	if selected != "" {
+		panel.defaultRegion = selected
+	}
+	panel.updateRegionsGUI()
+	panel.defaultRegion = defaultRegion
+}
+
+// Replaces compartments in dropdown keeping currently selected compartment selected.
+// Has to be called from GUI thread.
+func (panel *guiTopPanel) ReplaceCompartments(compartments *[]identity.Compartment) {
+	selectedId := panel.GetSelectedCompartmentId()
+	panel.compartmentsMu.Lock()
+	panel.compartments = compartments
+	panel.compartmentsMu.Unlock()
+	panel.updateCompartmentsGUI()
+	selIdx := 0
+	for idx, comp := range *compartments {
+		if *comp.Id == selectedId {
+			selIdx = idx + 1 // As the first one is empty
+		}
+	}
+	panel.compartmentsDropDown.SetCurrentOption(selIdx)
+}
+
 func (panel *guiTopPanel) IsToBeRefreshed() bool {
 	return panel.toBeRefreshed
 }
@@ -82,7 +120,7 @@ func (panel *guiTopPanel) GetGuiPrimitive() *tview.Grid {
 }
 
 func (panel *guiTopPanel) updateResourcesGUI() {
-	panel.resourcesDropDown.SetOptions([]string{"compartments", "instances"}, nil)
+	panel.resourcesDropDown.SetOptions([]string{"compartments", "instances", "resources", "users", "mycredentials", "groups", "policies", "tags", "regions", "domains", "topology", "alarms", "metrics", "workrequests"}, nil)
 }
 
 func (panel *guiTopPanel) updateRegionsGUI() {
@@ -92,7 +130,11 @@ func (panel *guiTopPanel) updateRegionsGUI() {
 		defer panel.compartmentsMu.Unlock()
 		var txt []string
 		for idx, reg := range *panel.regions {
-			txt = append(txt, *reg.Name)
+			if *reg.Name == panel.homeRegion {
+				txt = append(txt, *reg.Name+" (home)")
+			} else {
+				txt = append(txt, *reg.Name)
+			}
 			if panel.defaultRegion == *reg.Name || panel.defaultRegion == *reg.Key {
 				selIdx = idx
 			}
@@ -121,6 +163,7 @@ func (panel *guiTopPanel) init() {
 	panel.toBeRefreshed = false
 	panel.guiPrimitve = nil
 	panel.defaultRegion = ""
+	panel.homeRegion = ""
 }
 
 func (panel *guiTopPanel) createLayout() {
@@ -152,6 +195,15 @@ func (panel *guiTopPanel) createLayout() {
 	panel.guiPrimitve = mainGrid
 }
 
+func (panel *guiTopPanel) GetCompartments() []identity.Compartment {
+	panel.compartmentsMu.Lock()
+	defer panel.compartmentsMu.Unlock()
+	if panel.compartments == nil {
+		return nil
+	}
+	return *panel.compartments
+}
+
 func (panel *guiTopPanel) GetSelectedCompartment() *identity.Compartment {
 	idx, _ := panel.compartmentsDropDown.GetCurrentOption()
 	if idx > 0 && idx <= len(*panel.compartments) {
@@ -170,7 +222,7 @@ func (panel *guiTopPanel) GetSelectedCompartmentId() string {
 
 func (panel *guiTopPanel) GetSelectedRegion() *identity.Region {
 	idx, _ := panel.regionsDropDown.GetCurrentOption()
-	if idx > -1 && idx < len(*panel.regions) {
+	if panel.regions != nil && idx > -1 && idx < len(*panel.regions) {
 		return &(*panel.regions)[idx]
 	}
 	return nil
@@ -0,0 +1,254 @@
+package gui
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/core"
+	"github.com/rivo/tview"
+)
+
+const topologyAllVcns = "ALL VCNs"
+
+type topologyGUI struct {
+	mainGrid      *tview.Grid
+	vcnDropDown   *tview.DropDown
+	refreshButton *tview.Button
+	tree          *tview.TreeView
+}
+
+// Panel rendering network topology of VCN (or all VCNs of compartment) as tree.
+// Entities contain their children, relationships to other entities are listed
+// under the entity and selecting them jumps to the related entity.
+type TopologyPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *topologyGUI
+	vcns          []core.Vcn
+	topology      *oci.NetworkTopology
+	treeNodes     map[string]*tview.TreeNode
+	tenancyId     string
+	compartmentId string
+}
+
+func NewTopologyPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *TopologyPanel {
+	res := TopologyPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newTopologyGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewTopologyAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewTopologyPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newTopologyGUI() *topologyGUI {
+	res := topologyGUI{
+		mainGrid:      tview.NewGrid(),
+		vcnDropDown:   tview.NewDropDown(),
+		refreshButton: tview.NewButton("Refresh"),
+		tree:          tview.NewTreeView(),
+	}
+	return &res
+}
+
+func (panel *TopologyPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 40, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 35)
+	panel.gui.vcnDropDown.SetOptions([]string{topologyAllVcns}, nil)
+	panel.gui.vcnDropDown.SetCurrentOption(0)
+	panel.gui.vcnDropDown.SetBorder(true).SetTitle("VCN")
+	panel.gui.mainGrid.AddItem(panel.gui.vcnDropDown, 1, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 2, 1, 1, 0, 0, false)
+
+	panel.gui.tree.SetBorder(true).SetTitle("Network Topology (Enter: details or go to related entity)")
+	panel.gui.mainGrid.AddItem(panel.gui.tree, 2, 0, 1, 4, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *TopologyPanel) makeKeyBindings() {
+	panel.gui.vcnDropDown.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.refreshButton, panel.gui.refreshButton, nil))
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.vcnDropDown, panel.gui.vcnDropDown, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.tree.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		}
+	})
+	panel.gui.tree.SetSelectedFunc(func(treeNode *tview.TreeNode) {
+		switch ref := treeNode.GetReference().(type) {
+		case *oci.TopologyNode:
+			panel.showNodeDetail(ref)
+		case oci.TopologyLink:
+			if target, ok := panel.treeNodes[ref.TargetId]; ok {
+				panel.gui.tree.SetCurrentNode(target)
+			}
+		}
+	})
+}
+
+// Returns id of VCN selected in dropdown, empty if all VCNs are selected.
+func (panel *TopologyPanel) getSelectedVcnId() string {
+	idx, _ := panel.gui.vcnDropDown.GetCurrentOption()
+	if idx > 0 && idx <= len(panel.vcns) {
+		return *panel.vcns[idx-1].Id // As the first one is all VCNs
+	}
+	return ""
+}
+
+// Reloads VCNs of compartment and topology of selected VCN.
+func (panel *TopologyPanel) reload() {
+	vcnId := panel.getSelectedVcnId()
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.tree)
+			panel.guiController.RefreshGUI()
+		}()
+		vcns, err := panel.ociController.ListVcns(panel.compartmentId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		topology, err := panel.ociController.GetNetworkTopology(panel.compartmentId, vcnId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.vcns = vcns
+		panel.topology = topology
+		panel.refreshVcns(vcnId)
+		panel.refreshTree()
+	}()
+}
+
+func (panel *TopologyPanel) refreshVcns(selectedId string) {
+	txt := []string{topologyAllVcns}
+	selIdx := 0
+	for idx, vcn := range panel.vcns {
+		txt = append(txt, *vcn.DisplayName)
+		if *vcn.Id == selectedId {
+			selIdx = idx + 1
+		}
+	}
+	panel.gui.vcnDropDown.SetOptions(txt, nil)
+	panel.gui.vcnDropDown.SetCurrentOption(selIdx)
+}
+
+func (panel *TopologyPanel) refreshTree() {
+	panel.treeNodes = make(map[string]*tview.TreeNode)
+	_, vcnName := panel.gui.vcnDropDown.GetCurrentOption()
+	root := tview.NewTreeNode(tview.Escape(vcnName)).SetColor(tcell.ColorYellow).SetSelectable(false)
+	for _, node := range panel.topology.Roots {
+		root.AddChild(panel.newTreeNode(node))
+	}
+	panel.gui.tree.SetRoot(root)
+	if children := root.GetChildren(); len(children) > 0 {
+		panel.gui.tree.SetCurrentNode(children[0])
+	}
+}
+
+// Creates tree node of topology node with its relationships and contained entities.
+func (panel *TopologyPanel) newTreeNode(node *oci.TopologyNode) *tview.TreeNode {
+	treeNode := tview.NewTreeNode(fmt.Sprintf("%s: %s", node.Type, tview.Escape(node.Name))).
+		SetReference(node).
+		SetColor(topologyTypeColor(node.Type))
+	if _, ok := panel.treeNodes[node.Id]; ok {
+		// entity contained in more entities is expanded only once
+		return treeNode
+	}
+	panel.treeNodes[node.Id] = treeNode
+	for _, link := range node.Links {
+		text := fmt.Sprintf("-> %s %s", link.Kind, tview.Escape(panel.topology.NodeName(link.TargetId)))
+		if link.Details != "" {
+			text += " (" + tview.Escape(link.Details) + ")"
+		}
+		treeNode.AddChild(tview.NewTreeNode(text).SetReference(link).SetColor(tcell.ColorDarkGray))
+	}
+	for _, child := range node.Children {
+		treeNode.AddChild(panel.newTreeNode(child))
+	}
+	return treeNode
+}
+
+func topologyTypeColor(nodeType string) tcell.Color {
+	switch nodeType {
+	case "Vcn", "vcn":
+		return tcell.ColorGreen
+	case "Subnet", "subnet":
+		return tcell.ColorWhite
+	case "Drg", "drg", "DrgAttachment", "drgattachment":
+		return tcell.ColorOrange
+	case "LocalPeeringGateway", "localpeeringgateway", "RemotePeeringConnection", "remotepeeringconnection":
+		return tcell.ColorPurple
+	}
+	return tcell.ColorLightBlue
+}
+
+// Shows all attributes and relationships of topology entity.
+func (panel *TopologyPanel) showNodeDetail(node *oci.TopologyNode) {
+	pageName := "TopologyNodeDetail"
+	table := tview.NewTable().SetBorders(false).SetSelectable(true, false)
+	table.SetBorder(true).SetTitle(fmt.Sprintf("%s: %s (Esc: close)", node.Type, tview.Escape(node.Name)))
+	keys := make([]string, 0, len(node.Attributes))
+	for key := range node.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	row := 0
+	for _, key := range keys {
+		table.SetCell(row, 0, tview.NewTableCell(key).SetTextColor(tcell.ColorYellow))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(node.Attributes[key])))
+		row++
+	}
+	for _, link := range node.Links {
+		table.SetCell(row, 0, tview.NewTableCell(link.Kind).SetTextColor(tcell.ColorGreen))
+		table.SetCell(row, 1, tview.NewTableCell(tview.Escape(panel.topology.NodeName(link.TargetId)+" "+link.Details)))
+		row++
+	}
+	table.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.RemovePage(pageName, n_main)
+			panel.guiController.SetFocus(panel.gui.tree)
+		}
+	})
+	grid := tview.NewGrid().SetColumns(0, 120, 0).SetRows(0, 25, 0)
+	grid.AddItem(table, 1, 1, 1, 1, 0, 0, true)
+	panel.guiController.AddPage(pageName, grid, true)
+	panel.guiController.SetFocus(table)
+}
+
+func (panel *TopologyPanel) GetPanelName() string {
+	return "topology"
+}
+
+func (panel *TopologyPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *TopologyPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *TopologyPanel) GetInfo() string {
+	return "[red]Esc:[white] Exit [green]Enter:[white] Details or go to related entity"
+}
@@ -0,0 +1,320 @@
+package gui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+	"github.com/rivo/tview"
+)
+
+const defaultMaxCredentialAgeDays = 90
+
+type usersGUI struct {
+	mainGrid      *tview.Grid
+	maxAgeInput   *tview.InputField
+	refreshButton *tview.Button
+	mainTable     *tview.Table
+}
+
+// Panel listing IAM users of the tenancy with their groups and credentials age.
+// Credentials older than configured age are highlighted for rotation audits.
+type UsersPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *usersGUI
+	users         []oci.UserOverview
+	tenancyId     string
+	compartmentId string
+}
+
+func NewUsersPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *UsersPanel {
+	res := UsersPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newUsersGUI(),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewUsersAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewUsersPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newUsersGUI() *usersGUI {
+	res := usersGUI{
+		mainGrid:      tview.NewGrid(),
+		maxAgeInput:   tview.NewInputField(),
+		refreshButton: tview.NewButton("Refresh"),
+		mainTable:     tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *UsersPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 20, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 30)
+	panel.gui.maxAgeInput.SetAcceptanceFunc(tview.InputFieldInteger).
+		SetText(strconv.Itoa(defaultMaxCredentialAgeDays)).
+		SetBorder(true).SetTitle("Max key age days")
+	panel.gui.mainGrid.AddItem(panel.gui.maxAgeInput, 1, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 2, 1, 1, 0, 0, false)
+
+	panel.gui.mainTable.SetBorder(true).SetTitle("Users Table")
+	panel.gui.mainGrid.AddItem(panel.gui.mainTable, 2, 0, 1, 4, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *UsersPanel) makeKeyBindings() {
+	panel.gui.maxAgeInput.SetDoneFunc(func(key tcell.Key) {
+		if panel.users != nil {
+			panel.refreshTable()
+		}
+		panel.guiController.BindDefaultDoneFunc(panel.gui.refreshButton, panel.gui.refreshButton, nil)(key)
+	})
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.maxAgeInput, panel.gui.maxAgeInput, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.mainTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		}
+	})
+	panel.gui.mainTable.SetSelectedFunc(func(row, column int) {
+		if row < 1 || row > len(panel.users) {
+			return
+		}
+		panel.showCredentialsDetail(panel.users[row-1])
+	})
+}
+
+// Reloads users and their credentials from OCI.
+func (panel *UsersPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
+		}()
+		users, err := panel.ociController.ListUsersOverview()
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		sort.SliceStable(users, func(i, j int) bool {
+			return strings.ToLower(*users[i].User.Name) < strings.ToLower(*users[j].User.Name)
+		})
+		panel.users = users
+		panel.refreshTable()
+	}()
+}
+
+func (panel *UsersPanel) getMaxAge() time.Duration {
+	days, err := strconv.Atoi(panel.gui.maxAgeInput.GetText())
+	if err != nil || days <= 0 {
+		days = defaultMaxCredentialAgeDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// Returns age in days of the oldest credential and number of credentials older than maxAge.
+func oldestCredential(created []*common.SDKTime, maxAge time.Duration) (oldestDays int, expired int) {
+	oldestDays = -1
+	for _, t := range created {
+		if t == nil {
+			continue
+		}
+		age := time.Since(t.Time)
+		if days := int(age.Hours() / 24); days > oldestDays {
+			oldestDays = days
+		}
+		if age > maxAge {
+			expired++
+		}
+	}
+	return oldestDays, expired
+}
+
+func credentialsAgeToString(count int, oldestDays int) string {
+	if count == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d (oldest %dd)", count, oldestDays)
+}
+
+func (panel *UsersPanel) refreshTable() {
+	table := panel.gui.mainTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+
+	for col, header := range []string{"NAME", "STATE", "MFA", "EMAIL", "GROUPS", "API KEYS", "AUTH TOKENS"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+
+	maxAge := panel.getMaxAge()
+	for row, val := range panel.users {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		keysCreated := make([]*common.SDKTime, 0)
+		for _, key := range val.ApiKeys {
+			keysCreated = append(keysCreated, key.TimeCreated)
+		}
+		tokensCreated := make([]*common.SDKTime, 0)
+		for _, token := range val.AuthTokens {
+			tokensCreated = append(tokensCreated, token.TimeCreated)
+		}
+		oldestKey, expiredKeys := oldestCredential(keysCreated, maxAge)
+		oldestToken, expiredTokens := oldestCredential(tokensCreated, maxAge)
+
+		keysColor := cellcolor
+		if expiredKeys > 0 {
+			keysColor = tcell.ColorRed
+		}
+		tokensColor := cellcolor
+		if expiredTokens > 0 {
+			tokensColor = tcell.ColorRed
+		}
+		mfa, mfaColor := "NO", tcell.ColorYellow
+		if val.User.IsMfaActivated != nil && *val.User.IsMfaActivated {
+			mfa, mfaColor = "YES", tcell.ColorGreen
+		}
+		stateColor := tcell.ColorGreen
+		if val.User.LifecycleState != identity.UserLifecycleStateActive {
+			stateColor = tcell.ColorGray
+		}
+
+		table.SetCell(row, 0, tview.NewTableCell(*val.User.Name).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(string(val.User.LifecycleState)).SetAlign(tview.AlignCenter).SetTextColor(stateColor))
+		table.SetCell(row, 2, tview.NewTableCell(mfa).SetAlign(tview.AlignCenter).SetTextColor(mfaColor))
+		table.SetCell(row, 3, tview.NewTableCell(oci.StringOrEmpty(val.User.Email)).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 4, tview.NewTableCell(tview.Escape(strings.Join(val.Groups, ","))).SetAlign(tview.AlignLeft).SetTextColor(cellcolor).SetMaxWidth(40))
+		table.SetCell(row, 5, tview.NewTableCell(credentialsAgeToString(len(val.ApiKeys), oldestKey)).SetAlign(tview.AlignRight).SetTextColor(keysColor))
+		table.SetCell(row, 6, tview.NewTableCell(credentialsAgeToString(len(val.AuthTokens), oldestToken)).SetAlign(tview.AlignRight).SetTextColor(tokensColor))
+	}
+}
+
+// Shows API keys and auth tokens of the user.
+func (panel *UsersPanel) showCredentialsDetail(user oci.UserOverview) {
+	panelName := "UserCredentialsPanel"
+	maxAge := panel.getMaxAge()
+	ageCell := func(created *common.SDKTime) *tview.TableCell {
+		if created == nil {
+			return tview.NewTableCell("")
+		}
+		age := time.Since(created.Time)
+		cell := tview.NewTableCell(fmt.Sprintf("%dd", int(age.Hours()/24))).SetAlign(tview.AlignRight)
+		if age > maxAge {
+			cell.SetTextColor(tcell.ColorRed)
+		}
+		return cell
+	}
+
+	keysTable := tview.NewTable().SetSelectable(true, false)
+	keysTable.SetBorder(true).SetTitle("API keys")
+	for col, header := range []string{"FINGERPRINT", "CREATED", "AGE", "STATE"} {
+		keysTable.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, key := range user.ApiKeys {
+		row += 1
+		keysTable.SetCell(row, 0, tview.NewTableCell(oci.StringOrEmpty(key.Fingerprint)))
+		if key.TimeCreated != nil {
+			keysTable.SetCell(row, 1, tview.NewTableCell(key.TimeCreated.UTC().Format(time.RFC3339)))
+		}
+		keysTable.SetCell(row, 2, ageCell(key.TimeCreated))
+		keysTable.SetCell(row, 3, tview.NewTableCell(string(key.LifecycleState)))
+	}
+
+	tokensTable := tview.NewTable().SetSelectable(true, false)
+	tokensTable.SetBorder(true).SetTitle("Auth tokens")
+	for col, header := range []string{"DESCRIPTION", "CREATED", "AGE", "EXPIRES", "STATE"} {
+		tokensTable.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, token := range user.AuthTokens {
+		row += 1
+		tokensTable.SetCell(row, 0, tview.NewTableCell(tview.Escape(oci.StringOrEmpty(token.Description))))
+		if token.TimeCreated != nil {
+			tokensTable.SetCell(row, 1, tview.NewTableCell(token.TimeCreated.UTC().Format(time.RFC3339)))
+		}
+		tokensTable.SetCell(row, 2, ageCell(token.TimeCreated))
+		if token.TimeExpires != nil {
+			tokensTable.SetCell(row, 3, tview.NewTableCell(token.TimeExpires.UTC().Format(time.RFC3339)))
+		}
+		tokensTable.SetCell(row, 4, tview.NewTableCell(string(token.LifecycleState)))
+	}
+
+	close := func() {
+		panel.guiController.RemovePage(panelName, n_main)
+		panel.guiController.SetFocus(panel.gui.mainTable)
+	}
+	keysTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(tokensTable)
+		}
+		if tcell.KeyEscape == key {
+			close()
+		}
+	})
+	tokensTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyTab == key {
+			panel.guiController.SetFocus(keysTable)
+		}
+		if tcell.KeyEscape == key {
+			close()
+		}
+	})
+
+	ocid := tview.NewInputField().SetLabel("OCID:").SetText(*user.User.Id)
+	grid := tview.NewGrid()
+	grid.SetColumns(0)
+	grid.SetRows(1, 0, 0)
+	grid.AddItem(ocid, 0, 0, 1, 1, 0, 0, false)
+	grid.AddItem(keysTable, 1, 0, 1, 1, 0, 0, true)
+	grid.AddItem(tokensTable, 2, 0, 1, 1, 0, 0, false)
+	grid.SetBorder(true).SetTitle("Credentials of " + *user.User.Name)
+
+	outer := tview.NewGrid().SetColumns(0, 100, 0).SetRows(0, 25, 0)
+	outer.AddItem(grid, 1, 1, 1, 1, 0, 0, true)
+	panel.guiController.AddPage(panelName, outer, true)
+	panel.guiController.SetFocus(keysTable)
+}
+
+func (panel *UsersPanel) GetPanelName() string {
+	return "users"
+}
+
+func (panel *UsersPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *UsersPanel) Remove(pages *tview.Pages) {
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *UsersPanel) GetInfo() string {
+	return "[red]Enter:[white] Credentials [red]Esc:[white] Exit [yellow]red:[white] credential older than max age"
+}
@@ -0,0 +1,125 @@
+package gui
+
+import (
+	"fmt"
+
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/oracle/oci-go-sdk/v52/core"
+	"github.com/rivo/tview"
+)
+
+// Panel attaching block volume to compute instance.
+type VolumeAttachPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	instance      *core.Instance
+	volumes       []core.Volume
+	grid          *tview.Grid
+	form          *tview.Form
+	volumeSelect  *tview.DropDown
+	typeSelect    *tview.DropDown
+	readOnlyCheck *tview.Checkbox
+	closeFunc     func(attached bool)
+}
+
+func NewVolumeAttachPanel(GuiController *GuiController, OciController *oci.OCIController, Instance *core.Instance) *VolumeAttachPanel {
+	res := VolumeAttachPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		instance:      Instance,
+		grid:          tview.NewGrid(),
+		form:          tview.NewForm(),
+		volumeSelect:  tview.NewDropDown().SetLabel("Volume:"),
+		typeSelect:    tview.NewDropDown().SetLabel("Attachment type:"),
+		readOnlyCheck: tview.NewCheckbox().SetLabel("Read only:"),
+		closeFunc:     func(attached bool) {},
+	}
+	res.createGUI()
+	return &res
+}
+
+func (panel *VolumeAttachPanel) GetGUI() tview.Primitive {
+	return panel.grid
+}
+
+func (panel *VolumeAttachPanel) GetPanelName() string {
+	return "VolumeAttachPanel"
+}
+
+func (panel *VolumeAttachPanel) GetFocusPrimitive() tview.Primitive {
+	return panel.form
+}
+
+// Function called when panel is closed, attached is true if volume attachment was requested.
+func (panel *VolumeAttachPanel) SetCloseFunc(close func(attached bool)) {
+	panel.closeFunc = close
+}
+
+func (panel *VolumeAttachPanel) createGUI() {
+	panel.typeSelect.SetOptions([]string{oci.AttachmentParavirtualized, oci.AttachmentIscsi}, nil)
+	panel.typeSelect.SetCurrentOption(0)
+	panel.form.AddFormItem(panel.volumeSelect).
+		AddFormItem(panel.typeSelect).
+		AddFormItem(panel.readOnlyCheck).
+		AddButton("Attach", panel.attach).
+		AddButton("Cancel", func() { panel.closeFunc(false) })
+	panel.form.SetCancelFunc(func() { panel.closeFunc(false) })
+	panel.form.SetBorder(true).SetTitle("Attach Volume")
+
+	panel.grid.SetColumns(0, 80, 0)
+	panel.grid.SetRows(0, 11, 0)
+	panel.grid.AddItem(panel.form, 1, 1, 1, 1, 0, 0, true)
+}
+
+// Loads available volumes from the availability domain of the instance.
+func (panel *VolumeAttachPanel) LoadData() error {
+	volumes, err := panel.ociController.ListAttachableVolumes(*panel.instance.CompartmentId, *panel.instance.AvailabilityDomain)
+	if err != nil {
+		return err
+	}
+	if len(volumes) == 0 {
+		return fmt.Errorf("no available volumes in %s", *panel.instance.AvailabilityDomain)
+	}
+	panel.volumes = volumes
+	txt := make([]string, 0)
+	for _, v := range volumes {
+		txt = append(txt, fmt.Sprintf("%s (%d GB)", *v.DisplayName, oci.Int64OrZero(v.SizeInGBs)))
+	}
+	panel.volumeSelect.SetOptions(txt, nil)
+	panel.volumeSelect.SetCurrentOption(0)
+	return nil
+}
+
+func (panel *VolumeAttachPanel) attach() {
+	idx, volumeName := panel.volumeSelect.GetCurrentOption()
+	if idx < 0 || idx >= len(panel.volumes) {
+		return
+	}
+	volumeId := *panel.volumes[idx].Id
+	_, attachmentType := panel.typeSelect.GetCurrentOption()
+	readOnly := panel.readOnlyCheck.IsChecked()
+	modalName := "ModalVolumeAttachPanel"
+	modal := tview.NewModal().
+		SetText(fmt.Sprintf("Do you want to attach %s to %s as %s?", volumeName, *panel.instance.DisplayName, attachmentType)).
+		AddButtons([]string{"Attach", "Cancel"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			panel.guiController.RemovePage(modalName, panel.GetPanelName())
+			panel.guiController.SetFocus(panel.form)
+			if buttonLabel != "Attach" {
+				return
+			}
+			panel.guiController.SetLoading()
+			go func() {
+				defer func() {
+					panel.guiController.RemoveLoading()
+					panel.guiController.RefreshGUI()
+				}()
+				if err := panel.ociController.AttachVolume(*panel.instance.Id, volumeId, attachmentType, readOnly); err != nil {
+					panel.guiController.LogErrorOnPage(err.Error(), panel.GetPanelName(), panel.form)
+					return
+				}
+				panel.closeFunc(true)
+			}()
+		})
+	panel.guiController.AddPage(modalName, modal, false)
+}
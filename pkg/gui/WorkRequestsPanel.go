@@ -0,0 +1,315 @@
+package gui
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gdamore/tcell/v2"
+	oci "github.com/jszczuko/ociterm/pkg/oci"
+	"github.com/rivo/tview"
+)
+
+const workRequestsRefreshInterval = 5 * time.Second
+
+type workRequestsGUI struct {
+	mainGrid      *tview.Grid
+	autoCheck     *tview.Checkbox
+	refreshButton *tview.Button
+	mainTable     *tview.Table
+}
+
+// Panel tracking compartment, IAM and tagging work requests of the compartment.
+// While any work request is in progress the list is refreshed automatically.
+type WorkRequestsPanel struct {
+	guiController *GuiController
+	ociController *oci.OCIController
+	gui           *workRequestsGUI
+	workRequests  []oci.IdentityWorkRequest
+	tenancyId     string
+	compartmentId string
+	refreshing    bool
+	refreshMu     sync.Mutex
+	stop          chan struct{}
+	stopOnce      sync.Once
+}
+
+// Work requests of CompartmentId are listed, tenancy ones if it is empty.
+func NewWorkRequestsPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *WorkRequestsPanel {
+	if CompartmentId == "" {
+		CompartmentId = TenancyId
+	}
+	res := WorkRequestsPanel{
+		guiController: GuiController,
+		ociController: OciController,
+		tenancyId:     TenancyId,
+		compartmentId: CompartmentId,
+		gui:           newWorkRequestsGUI(),
+		stop:          make(chan struct{}),
+	}
+	res.createGUI()
+	return &res
+}
+
+func NewWorkRequestsAsGUIPanel(TenancyId string, CompartmentId string, OciController *oci.OCIController, GuiController *GuiController) *GUIPanel {
+	var inter interface{}
+	var gui GUIPanel
+	inter = NewWorkRequestsPanel(TenancyId, CompartmentId, OciController, GuiController)
+	gui = inter.(GUIPanel)
+	return &gui
+}
+
+func newWorkRequestsGUI() *workRequestsGUI {
+	res := workRequestsGUI{
+		mainGrid:      tview.NewGrid(),
+		autoCheck:     tview.NewCheckbox().SetLabel("Auto refresh: ").SetChecked(true),
+		refreshButton: tview.NewButton("Refresh"),
+		mainTable:     tview.NewTable(),
+	}
+	return &res
+}
+
+func (panel *WorkRequestsPanel) createGUI() {
+	panel.gui.mainGrid.SetColumns(0, 20, 20, 0)
+	panel.gui.mainGrid.SetRows(0, 3, 30)
+	panel.gui.autoCheck.SetBorder(true)
+	panel.gui.mainGrid.AddItem(panel.gui.autoCheck, 1, 1, 1, 1, 0, 0, false)
+	panel.gui.mainGrid.AddItem(WrapButton(panel.gui.refreshButton), 1, 2, 1, 1, 0, 0, false)
+
+	panel.gui.mainTable.SetBorder(true).SetTitle("Work Requests Table")
+	panel.gui.mainGrid.AddItem(panel.gui.mainTable, 2, 0, 1, 4, 0, 0, false)
+
+	panel.makeKeyBindings()
+}
+
+func (panel *WorkRequestsPanel) makeKeyBindings() {
+	panel.gui.autoCheck.SetDoneFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.refreshButton, panel.gui.refreshButton, nil))
+	panel.gui.autoCheck.SetChangedFunc(func(checked bool) {
+		if checked {
+			panel.scheduleRefresh()
+		}
+	})
+	panel.gui.refreshButton.SetExitFunc(panel.guiController.BindDefaultDoneFunc(panel.gui.autoCheck, panel.gui.autoCheck, nil))
+	panel.gui.refreshButton.SetSelectedFunc(panel.reload)
+
+	panel.gui.mainTable.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			panel.guiController.SetFocus(panel.gui.refreshButton)
+		}
+	})
+	panel.gui.mainTable.SetSelectedFunc(func(row, column int) {
+		if row < 1 || row > len(panel.workRequests) {
+			return
+		}
+		panel.showDetail(panel.workRequests[row-1])
+	})
+}
+
+// Reloads work requests from OCI.
+func (panel *WorkRequestsPanel) reload() {
+	panel.guiController.SetLoading()
+	go func() {
+		defer func() {
+			panel.guiController.RemoveLoading()
+			panel.guiController.SetFocus(panel.gui.mainTable)
+			panel.guiController.RefreshGUI()
+		}()
+		workRequests, err := panel.ociController.ListIdentityWorkRequests(panel.compartmentId)
+		if err != nil {
+			panel.guiController.LogError(err.Error(), true)
+			return
+		}
+		panel.workRequests = workRequests
+		panel.refreshTable()
+		panel.scheduleRefresh()
+	}()
+}
+
+// Starts background refresh if auto refresh is on and some work request is not finished.
+// Only one background refresh is running at a time, it stops when panel is removed.
+func (panel *WorkRequestsPanel) scheduleRefresh() {
+	if !panel.gui.autoCheck.IsChecked() || !panel.hasPending(panel.workRequests) {
+		return
+	}
+	panel.refreshMu.Lock()
+	defer panel.refreshMu.Unlock()
+	if panel.refreshing {
+		return
+	}
+	panel.refreshing = true
+	go func() {
+		defer func() {
+			panel.refreshMu.Lock()
+			panel.refreshing = false
+			panel.refreshMu.Unlock()
+		}()
+		ticker := time.NewTicker(workRequestsRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-panel.stop:
+				return
+			case <-ticker.C:
+			}
+			if !panel.gui.autoCheck.IsChecked() {
+				return
+			}
+			workRequests, err := panel.ociController.ListIdentityWorkRequests(panel.compartmentId)
+			if err != nil {
+				panel.guiController.LogError(err.Error(), false)
+				return
+			}
+			panel.guiController.QueueUpdateDraw(func() {
+				panel.workRequests = workRequests
+				panel.refreshTable()
+			})
+			if !panel.hasPending(workRequests) {
+				return
+			}
+		}
+	}()
+}
+
+func (panel *WorkRequestsPanel) hasPending(workRequests []oci.IdentityWorkRequest) bool {
+	for _, w := range workRequests {
+		if !w.IsFinished() {
+			return true
+		}
+	}
+	return false
+}
+
+func (panel *WorkRequestsPanel) refreshTable() {
+	table := panel.gui.mainTable
+	table.Clear()
+	table.SetSelectable(true, false).SetBorders(false)
+	for col, header := range []string{"KIND", "OPERATION", "STATUS", "PROGRESS", "ACCEPTED", "FINISHED", "ID"} {
+		table.SetCell(0, col, tview.NewTableCell(header).SetAlign(tview.AlignCenter).SetSelectable(false))
+	}
+	for row, val := range panel.workRequests {
+		row += 1
+		var cellcolor tcell.Color
+		if row%2 == 0 {
+			cellcolor = tcell.ColorWhite
+		} else {
+			cellcolor = tcell.ColorDarkGray
+		}
+		finished := ""
+		if !val.TimeFinished.IsZero() {
+			finished = val.TimeFinished.Local().Format(time.RFC822)
+		}
+		table.SetCell(row, 0, tview.NewTableCell(val.Kind).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 1, tview.NewTableCell(val.OperationType).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 2, tview.NewTableCell(val.Status).SetAlign(tview.AlignCenter).SetTextColor(workRequestStatusColor(val.Status)))
+		table.SetCell(row, 3, tview.NewTableCell(progressBar(val.PercentComplete)).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+		table.SetCell(row, 4, tview.NewTableCell(val.TimeAccepted.Local().Format(time.RFC822)).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 5, tview.NewTableCell(finished).SetAlign(tview.AlignCenter).SetTextColor(cellcolor))
+		table.SetCell(row, 6, tview.NewTableCell(val.Id).SetAlign(tview.AlignLeft).SetTextColor(cellcolor))
+	}
+}
+
+func workRequestStatusColor(status string) tcell.Color {
+	switch status {
+	case "SUCCEEDED":
+		return tcell.ColorGreen
+	case "FAILED", "CANCELED":
+		return tcell.ColorRed
+	case "PARTIALLY_SUCCEEDED", "CANCELING":
+		return tcell.ColorOrange
+	}
+	return tcell.ColorYellow
+}
+
+// Renders percentage as bar of 10 characters followed by the number.
+func progressBar(percent float32) string {
+	done := int(percent / 10)
+	if done > 10 {
+		done = 10
+	}
+	return fmt.Sprintf("%s%s %3.0f%%", strings.Repeat("█", done), strings.Repeat("░", 10-done), percent)
+}
+
+// Shows errors and logs of work request, with auto refresh on they are refreshed until work request is finished.
+func (panel *WorkRequestsPanel) showDetail(workRequest oci.IdentityWorkRequest) {
+	pageName := "WorkRequestDetail"
+	text := tview.NewTextView().SetDynamicColors(true).SetScrollable(true)
+	text.SetBorder(true).SetTitle(fmt.Sprintf("%s %s (Esc: close)", workRequest.Kind, workRequest.OperationType))
+	closed := make(chan struct{})
+	var closeOnce sync.Once
+	text.SetDoneFunc(func(key tcell.Key) {
+		if tcell.KeyEscape == key {
+			closeOnce.Do(func() { close(closed) })
+			panel.guiController.RemovePage(pageName, n_main)
+			panel.guiController.SetFocus(panel.gui.mainTable)
+		}
+	})
+	grid := tview.NewGrid().SetColumns(0, 120, 0).SetRows(0, 30, 0)
+	grid.AddItem(text, 1, 1, 1, 1, 0, 0, true)
+	panel.guiController.AddPage(pageName, grid, true)
+	panel.guiController.SetFocus(text)
+
+	load := func() bool {
+		current, err := panel.ociController.GetIdentityWorkRequest(workRequest.Kind, workRequest.Id)
+		if err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), pageName, text)
+			return false
+		}
+		workRequestErrors, logs, err := panel.ociController.ListIdentityWorkRequestMessages(workRequest.Kind, workRequest.Id)
+		if err != nil {
+			panel.guiController.LogErrorOnPage(err.Error(), pageName, text)
+			return false
+		}
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "[yellow]Id:[white] %s\n", current.Id)
+		fmt.Fprintf(&sb, "[yellow]Status:[white] %s  %s\n", current.Status, progressBar(current.PercentComplete))
+		for _, r := range current.Resources {
+			fmt.Fprintf(&sb, "[yellow]Resource:[white] %s\n", tview.Escape(r))
+		}
+		sb.WriteString("\n[red]Errors:[white]\n")
+		for _, e := range workRequestErrors {
+			fmt.Fprintf(&sb, "%s [red]%s[white] %s\n", e.Timestamp.Local().Format(time.RFC822), tview.Escape(e.Code), tview.Escape(e.Message))
+		}
+		sb.WriteString("\n[green]Logs:[white]\n")
+		for _, l := range logs {
+			fmt.Fprintf(&sb, "%s %s\n", l.Timestamp.Local().Format(time.RFC822), tview.Escape(l.Message))
+		}
+		panel.guiController.QueueUpdateDraw(func() {
+			text.SetText(sb.String())
+		})
+		return !current.IsFinished() && panel.gui.autoCheck.IsChecked()
+	}
+	go func() {
+		for load() {
+			select {
+			case <-closed:
+				return
+			case <-panel.stop:
+				return
+			case <-time.After(workRequestsRefreshInterval):
+			}
+		}
+	}()
+}
+
+func (panel *WorkRequestsPanel) GetPanelName() string {
+	return "workrequests"
+}
+
+func (panel *WorkRequestsPanel) Show(pages *tview.Pages) {
+	if !pages.HasPage(panel.GetPanelName()) {
+		pages.AddAndSwitchToPage(panel.GetPanelName(), panel.gui.mainGrid, true)
+		panel.guiController.GetSetFocusFunc(panel.gui.refreshButton)()
+	}
+}
+
+func (panel *WorkRequestsPanel) Remove(pages *tview.Pages) {
+	panel.stopOnce.Do(func() { close(panel.stop) })
+	if pages.HasPage(panel.GetPanelName()) {
+		pages.RemovePage(panel.GetPanelName())
+	}
+}
+
+func (panel *WorkRequestsPanel) GetInfo() string {
+	return "[red]Esc:[white] Exit [green]Enter:[white] Errors and logs"
+}
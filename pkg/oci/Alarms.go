@@ -0,0 +1,152 @@
+package controller
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/monitoring"
+)
+
+// Alarm with its current status, Status is empty if alarm has no status (e.g. it is disabled).
+type AlarmOverview struct {
+	Alarm              monitoring.AlarmSummary
+	Status             monitoring.AlarmStatusSummaryStatusEnum
+	TimestampTriggered time.Time
+}
+
+// Lists alarms of the compartment with their current status.
+func (controller *OCIController) ListAlarmsOverview(compartmentId string) (overview []AlarmOverview, err error) {
+	alarms, err := controller.monitoringCtrl.ListAlarms(controller.context, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := controller.monitoringCtrl.ListAlarmsStatus(controller.context, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	statusById := make(map[string]monitoring.AlarmStatusSummary)
+	for _, status := range statuses {
+		statusById[*status.Id] = status
+	}
+	overview = make([]AlarmOverview, 0)
+	for _, alarm := range alarms {
+		item := AlarmOverview{Alarm: alarm}
+		if status, ok := statusById[*alarm.Id]; ok {
+			item.Status = status.Status
+			item.TimestampTriggered = sdkTimeOrZero(status.TimestampTriggered)
+		}
+		overview = append(overview, item)
+	}
+	return overview, nil
+}
+
+// Returns state transitions of the alarm from last days, newest first.
+func (controller *OCIController) GetAlarmHistory(alarmId string, days int) (entries []monitoring.AlarmHistoryEntry, err error) {
+	entries, err = controller.monitoringCtrl.GetAlarmHistory(controller.context, alarmId,
+		monitoring.GetAlarmHistoryAlarmHistorytypeTransitionHistory, time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return sdkTimeOrZero(entries[i].Timestamp).After(sdkTimeOrZero(entries[j].Timestamp))
+	})
+	return entries, nil
+}
+
+// Suppresses notifications of the alarm for given duration.
+func (controller *OCIController) SuppressAlarm(alarmId string, duration time.Duration, description string) error {
+	_, err := controller.monitoringCtrl.SuppressAlarm(controller.context, alarmId, time.Now().Add(duration), description)
+	return err
+}
+
+func (controller *OCIController) RemoveAlarmSuppression(alarmId string) error {
+	return controller.monitoringCtrl.RemoveAlarmSuppression(controller.context, alarmId)
+}
+
+// Alarm settings editable from alarm form.
+type AlarmDefinition struct {
+	DisplayName     string
+	Namespace       string
+	Query           string
+	Severity        string
+	PendingDuration string
+	Destinations    []string
+	Body            string
+	IsEnabled       bool
+}
+
+// Alarm trigger operators of MQL, longer ones first so >= is not taken for >.
+var alarmTriggerOperators = []string{"not in", "in", ">=", "<=", "==", "!=", ">", "<"}
+
+// Splits alarm query to metric query and trigger rule, e.g.
+// "CpuUtilization[1m].mean() > 80" to "CpuUtilization[1m].mean()" and "> 80".
+func SplitAlarmQuery(query string) (metricQuery string, trigger string) {
+	idx := strings.LastIndex(query, ")")
+	if idx < 0 {
+		return strings.TrimSpace(query), ""
+	}
+	rest := strings.TrimSpace(query[idx+1:])
+	for _, operator := range alarmTriggerOperators {
+		if strings.HasPrefix(rest, operator) {
+			return strings.TrimSpace(query[:idx+1]), rest
+		}
+	}
+	return strings.TrimSpace(query), ""
+}
+
+// Joins metric query and trigger rule to alarm query.
+func JoinAlarmQuery(metricQuery string, trigger string) string {
+	if strings.TrimSpace(trigger) == "" {
+		return strings.TrimSpace(metricQuery)
+	}
+	return strings.TrimSpace(metricQuery) + " " + strings.TrimSpace(trigger)
+}
+
+func (controller *OCIController) GetAlarm(alarmId string) (alarm *monitoring.Alarm, err error) {
+	return controller.monitoringCtrl.GetAlarm(controller.context, alarmId)
+}
+
+// Creates alarm in compartment watching metrics of the same compartment.
+func (controller *OCIController) CreateAlarm(compartmentId string, definition AlarmDefinition) (alarm *monitoring.Alarm, err error) {
+	details := monitoring.CreateAlarmDetails{
+		DisplayName:         common.String(definition.DisplayName),
+		CompartmentId:       common.String(compartmentId),
+		MetricCompartmentId: common.String(compartmentId),
+		Namespace:           common.String(definition.Namespace),
+		Query:               common.String(definition.Query),
+		Severity:            monitoring.AlarmSeverityEnum(definition.Severity),
+		Destinations:        definition.Destinations,
+		IsEnabled:           common.Bool(definition.IsEnabled),
+	}
+	if definition.PendingDuration != "" {
+		details.PendingDuration = common.String(definition.PendingDuration)
+	}
+	if definition.Body != "" {
+		details.Body = common.String(definition.Body)
+	}
+	return controller.monitoringCtrl.CreateAlarm(controller.context, details)
+}
+
+func (controller *OCIController) UpdateAlarm(alarmId string, definition AlarmDefinition) (alarm *monitoring.Alarm, err error) {
+	details := monitoring.UpdateAlarmDetails{
+		DisplayName:  common.String(definition.DisplayName),
+		Namespace:    common.String(definition.Namespace),
+		Query:        common.String(definition.Query),
+		Severity:     monitoring.AlarmSeverityEnum(definition.Severity),
+		Destinations: definition.Destinations,
+		IsEnabled:    common.Bool(definition.IsEnabled),
+		Body:         common.String(definition.Body),
+	}
+	if definition.PendingDuration != "" {
+		details.PendingDuration = common.String(definition.PendingDuration)
+	}
+	return controller.monitoringCtrl.UpdateAlarm(controller.context, alarmId, details)
+}
+
+// Runs metric query of alarm (without trigger rule) over last 24 hours.
+func (controller *OCIController) PreviewAlarmQuery(compartmentId string, namespace string, query string) (series []MetricSeries, err error) {
+	end := time.Now()
+	return controller.QueryMetrics(compartmentId, namespace, query, end.Add(-24*time.Hour), end)
+}
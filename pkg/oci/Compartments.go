@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+)
+
+// Compartment operations are IAM writes, requests are sent to home region of the tenancy.
+
+func (controller *OCIController) CreateCompartment(parentId string, name string, description string) (compartment *identity.Compartment, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.CreateCompartment(controller.context, tenancyId, identity.CreateCompartmentDetails{
+		CompartmentId: common.String(parentId),
+		Name:          common.String(name),
+		Description:   common.String(description),
+	})
+}
+
+func (controller *OCIController) UpdateCompartment(compartmentId string, name string, description string) (compartment *identity.Compartment, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.UpdateCompartment(controller.context, tenancyId, compartmentId, identity.UpdateCompartmentDetails{
+		Name:        common.String(name),
+		Description: common.String(description),
+	})
+}
+
+func (controller *OCIController) RecoverCompartment(compartmentId string) (compartment *identity.Compartment, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.RecoverCompartment(controller.context, tenancyId, compartmentId)
+}
+
+// Moves compartment under target compartment and waits until move is finished.
+// Progress is reported as text through onProgress (can be nil).
+func (controller *OCIController) MoveCompartment(compartmentId string, targetCompartmentId string, onProgress func(progress string)) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	workRequestId, err := controller.identityCtrl.MoveCompartment(controller.context, tenancyId, compartmentId, targetCompartmentId)
+	if err != nil {
+		return err
+	}
+	return controller.waitForIamWorkRequest(tenancyId, workRequestId, onProgress)
+}
+
+// Deletes compartment and waits until deletion is finished.
+// Progress is reported as text through onProgress (can be nil).
+func (controller *OCIController) DeleteCompartment(compartmentId string, onProgress func(progress string)) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	workRequestId, err := controller.identityCtrl.DeleteCompartment(controller.context, tenancyId, compartmentId)
+	if err != nil {
+		return err
+	}
+	return controller.waitForIamWorkRequest(tenancyId, workRequestId, onProgress)
+}
+
+func (controller *OCIController) waitForIamWorkRequest(tenancyId string, workRequestId string, onProgress func(progress string)) error {
+	if workRequestId == "" {
+		return nil
+	}
+	report := func(progress string) {
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+	report(fmt.Sprintf("work request %s accepted", workRequestId))
+	wr, err := controller.identityCtrl.WaitForWorkRequest(controller.context, tenancyId, workRequestId, 10*time.Second, func(wr *identity.WorkRequest) {
+		var percent float32
+		if wr.PercentComplete != nil {
+			percent = *wr.PercentComplete
+		}
+		report(fmt.Sprintf("work request %s: %s %.0f%%", *wr.Id, wr.Status, percent))
+	})
+	if err != nil && wr != nil && len(wr.Errors) > 0 {
+		return fmt.Errorf("%s: %s", err.Error(), StringOrEmpty(wr.Errors[0].Message))
+	}
+	return err
+}
@@ -3,6 +3,8 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v52/common"
 	"github.com/oracle/oci-go-sdk/v52/core"
@@ -10,25 +12,47 @@ import (
 
 type coreController struct {
 	computeClient *core.ComputeClient
+	networkClient *core.VirtualNetworkClient
+	storageClient *core.BlockstorageClient
 	initiated     bool
 }
 
 func newCoreController() *coreController {
 	return &coreController{
 		computeClient: nil,
+		networkClient: nil,
+		storageClient: nil,
 		initiated:     false,
 	}
 }
 
 func (controller *coreController) init(ConfigProvider *common.ConfigurationProvider) error {
-	if c, err := core.NewComputeClientWithConfigurationProvider(*ConfigProvider); err == nil {
-		controller.computeClient = &c
-		controller.initiated = true
-		return nil
-	} else {
+	c, err := core.NewComputeClientWithConfigurationProvider(*ConfigProvider)
+	if err != nil {
+		controller.initiated = false
+		return err
+	}
+	n, err := core.NewVirtualNetworkClientWithConfigurationProvider(*ConfigProvider)
+	if err != nil {
+		controller.initiated = false
+		return err
+	}
+	b, err := core.NewBlockstorageClientWithConfigurationProvider(*ConfigProvider)
+	if err != nil {
 		controller.initiated = false
 		return err
 	}
+	controller.computeClient = &c
+	controller.networkClient = &n
+	controller.storageClient = &b
+	controller.initiated = true
+	return nil
+}
+
+func (controller *coreController) setRegion(region string) {
+	controller.computeClient.SetRegion(region)
+	controller.networkClient.SetRegion(region)
+	controller.storageClient.SetRegion(region)
 }
 
 func (controller *coreController) ListInstances(Ctx context.Context,
@@ -94,3 +118,540 @@ func (controller *coreController) GetInstance(Ctx context.Context, OcidId string
 	}
 	return &response.Instance, nil
 }
+
+func (controller *coreController) ListShapes(Ctx context.Context, CompartmentId string, AvailabilityDomain string) (shapes []core.Shape, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListShapesRequest{
+		CompartmentId:      common.String(CompartmentId),
+		AvailabilityDomain: common.String(AvailabilityDomain),
+	}
+	for {
+		response, err := controller.computeClient.ListShapes(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		shapes = append(shapes, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return shapes, nil
+}
+
+func (controller *coreController) ListImages(Ctx context.Context, CompartmentId string, Shape string) (images []core.Image, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListImagesRequest{
+		CompartmentId:  common.String(CompartmentId),
+		LifecycleState: core.ImageLifecycleStateAvailable,
+		SortBy:         core.ListImagesSortByDisplayname,
+		SortOrder:      core.ListImagesSortOrderAsc,
+	}
+	// empty shape lists images for all shapes
+	if Shape != "" {
+		request.Shape = common.String(Shape)
+	}
+	for {
+		response, err := controller.computeClient.ListImages(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return images, nil
+}
+
+func (controller *coreController) ListSubnets(Ctx context.Context, CompartmentId string) (subnets []core.Subnet, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListSubnetsRequest{
+		CompartmentId:  common.String(CompartmentId),
+		LifecycleState: core.SubnetLifecycleStateAvailable,
+		SortBy:         core.ListSubnetsSortByDisplayname,
+		SortOrder:      core.ListSubnetsSortOrderAsc,
+	}
+	for {
+		response, err := controller.networkClient.ListSubnets(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		subnets = append(subnets, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return subnets, nil
+}
+
+func (controller *coreController) LaunchInstance(Ctx context.Context, Details core.LaunchInstanceDetails) (instance *core.Instance, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.LaunchInstanceRequest{
+		LaunchInstanceDetails: Details,
+	}
+	response, err := controller.computeClient.LaunchInstance(Ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Instance, nil
+}
+
+// Polls instance every Interval until it reaches State.
+// OnPoll is called with every fetched version of instance (can be nil).
+// Returns error if instance ends in TERMINATED state while other state is expected.
+func (controller *coreController) WaitForInstanceState(Ctx context.Context,
+	OcidId string,
+	State core.InstanceLifecycleStateEnum,
+	Interval time.Duration,
+	OnPoll func(instance *core.Instance)) (instance *core.Instance, err error) {
+	for {
+		instance, err = controller.GetInstance(Ctx, OcidId)
+		if err != nil {
+			return nil, err
+		}
+		if OnPoll != nil {
+			OnPoll(instance)
+		}
+		if instance.LifecycleState == State {
+			return instance, nil
+		}
+		if instance.LifecycleState == core.InstanceLifecycleStateTerminated {
+			return instance, fmt.Errorf("instance %s is %s", OcidId, instance.LifecycleState)
+		}
+		select {
+		case <-Ctx.Done():
+			return instance, Ctx.Err()
+		case <-time.After(Interval):
+		}
+	}
+}
+
+func (controller *coreController) TerminateInstance(Ctx context.Context, OcidId string, PreserveBootVolume bool) error {
+	if !controller.initiated {
+		return errors.New("core Controller not initiated")
+	}
+	request := core.TerminateInstanceRequest{
+		InstanceId:         common.String(OcidId),
+		PreserveBootVolume: common.Bool(PreserveBootVolume),
+	}
+	_, err := controller.computeClient.TerminateInstance(Ctx, request)
+	return err
+}
+
+func (controller *coreController) ListVolumeAttachments(Ctx context.Context, CompartmentId string, InstanceId string) (attachments []core.VolumeAttachment, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListVolumeAttachmentsRequest{
+		CompartmentId: common.String(CompartmentId),
+		InstanceId:    common.String(InstanceId),
+	}
+	for {
+		response, err := controller.computeClient.ListVolumeAttachments(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return attachments, nil
+}
+
+func (controller *coreController) ListBootVolumeAttachments(Ctx context.Context, CompartmentId string, AvailabilityDomain string, InstanceId string) (attachments []core.BootVolumeAttachment, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListBootVolumeAttachmentsRequest{
+		CompartmentId:      common.String(CompartmentId),
+		AvailabilityDomain: common.String(AvailabilityDomain),
+		InstanceId:         common.String(InstanceId),
+	}
+	for {
+		response, err := controller.computeClient.ListBootVolumeAttachments(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return attachments, nil
+}
+
+func (controller *coreController) ListVnicAttachments(Ctx context.Context, CompartmentId string, InstanceId string) (attachments []core.VnicAttachment, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListVnicAttachmentsRequest{
+		CompartmentId: common.String(CompartmentId),
+		InstanceId:    common.String(InstanceId),
+	}
+	for {
+		response, err := controller.computeClient.ListVnicAttachments(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return attachments, nil
+}
+
+func (controller *coreController) GetVnic(Ctx context.Context, VnicId string) (vnic *core.Vnic, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	response, err := controller.networkClient.GetVnic(Ctx, core.GetVnicRequest{VnicId: common.String(VnicId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Vnic, nil
+}
+
+func (controller *coreController) UpdateInstance(Ctx context.Context, OcidId string, Details core.UpdateInstanceDetails) (instance *core.Instance, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.UpdateInstanceRequest{
+		InstanceId:            common.String(OcidId),
+		UpdateInstanceDetails: Details,
+	}
+	response, err := controller.computeClient.UpdateInstance(Ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Instance, nil
+}
+
+func (controller *coreController) ListVolumes(Ctx context.Context, CompartmentId string) (volumes []core.Volume, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListVolumesRequest{
+		CompartmentId: common.String(CompartmentId),
+		SortBy:        core.ListVolumesSortByDisplayname,
+		SortOrder:     core.ListVolumesSortOrderAsc,
+	}
+	for {
+		response, err := controller.storageClient.ListVolumes(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return volumes, nil
+}
+
+func (controller *coreController) ListBootVolumes(Ctx context.Context, CompartmentId string, AvailabilityDomain string) (volumes []core.BootVolume, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListBootVolumesRequest{
+		CompartmentId:      common.String(CompartmentId),
+		AvailabilityDomain: common.String(AvailabilityDomain),
+	}
+	for {
+		response, err := controller.storageClient.ListBootVolumes(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return volumes, nil
+}
+
+func (controller *coreController) ListVcns(Ctx context.Context, CompartmentId string) (vcns []core.Vcn, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListVcnsRequest{
+		CompartmentId: common.String(CompartmentId),
+		SortBy:        core.ListVcnsSortByDisplayname,
+		SortOrder:     core.ListVcnsSortOrderAsc,
+	}
+	for {
+		response, err := controller.networkClient.ListVcns(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		vcns = append(vcns, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return vcns, nil
+}
+
+func (controller *coreController) GetVolume(Ctx context.Context, VolumeId string) (volume *core.Volume, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	response, err := controller.storageClient.GetVolume(Ctx, core.GetVolumeRequest{VolumeId: common.String(VolumeId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Volume, nil
+}
+
+func (controller *coreController) GetBootVolume(Ctx context.Context, BootVolumeId string) (volume *core.BootVolume, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	response, err := controller.storageClient.GetBootVolume(Ctx, core.GetBootVolumeRequest{BootVolumeId: common.String(BootVolumeId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.BootVolume, nil
+}
+
+func (controller *coreController) GetImage(Ctx context.Context, ImageId string) (image *core.Image, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	response, err := controller.computeClient.GetImage(Ctx, core.GetImageRequest{ImageId: common.String(ImageId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Image, nil
+}
+
+// Moves instance to other compartment, returns id of work request tracking the move.
+func (controller *coreController) ChangeInstanceCompartment(Ctx context.Context, InstanceId string, CompartmentId string) (workRequestId string, err error) {
+	if !controller.initiated {
+		return "", errors.New("core Controller not initiated")
+	}
+	request := core.ChangeInstanceCompartmentRequest{
+		InstanceId: common.String(InstanceId),
+		ChangeInstanceCompartmentDetails: core.ChangeInstanceCompartmentDetails{
+			CompartmentId: common.String(CompartmentId),
+		},
+	}
+	response, err := controller.computeClient.ChangeInstanceCompartment(Ctx, request)
+	if err != nil {
+		return "", err
+	}
+	if response.OpcWorkRequestId == nil {
+		return "", nil
+	}
+	return *response.OpcWorkRequestId, nil
+}
+
+// Moves VCN to other compartment, returns id of work request tracking the move.
+func (controller *coreController) ChangeVcnCompartment(Ctx context.Context, VcnId string, CompartmentId string) (workRequestId string, err error) {
+	if !controller.initiated {
+		return "", errors.New("core Controller not initiated")
+	}
+	request := core.ChangeVcnCompartmentRequest{
+		VcnId: common.String(VcnId),
+		ChangeVcnCompartmentDetails: core.ChangeVcnCompartmentDetails{
+			CompartmentId: common.String(CompartmentId),
+		},
+	}
+	response, err := controller.networkClient.ChangeVcnCompartment(Ctx, request)
+	if err != nil {
+		return "", err
+	}
+	if response.OpcWorkRequestId == nil {
+		return "", nil
+	}
+	return *response.OpcWorkRequestId, nil
+}
+
+func (controller *coreController) ChangeVolumeCompartment(Ctx context.Context, VolumeId string, CompartmentId string) error {
+	if !controller.initiated {
+		return errors.New("core Controller not initiated")
+	}
+	request := core.ChangeVolumeCompartmentRequest{
+		VolumeId: common.String(VolumeId),
+		ChangeVolumeCompartmentDetails: core.ChangeVolumeCompartmentDetails{
+			CompartmentId: common.String(CompartmentId),
+		},
+	}
+	_, err := controller.storageClient.ChangeVolumeCompartment(Ctx, request)
+	return err
+}
+
+func (controller *coreController) ChangeBootVolumeCompartment(Ctx context.Context, BootVolumeId string, CompartmentId string) error {
+	if !controller.initiated {
+		return errors.New("core Controller not initiated")
+	}
+	request := core.ChangeBootVolumeCompartmentRequest{
+		BootVolumeId: common.String(BootVolumeId),
+		ChangeBootVolumeCompartmentDetails: core.ChangeBootVolumeCompartmentDetails{
+			CompartmentId: common.String(CompartmentId),
+		},
+	}
+	_, err := controller.storageClient.ChangeBootVolumeCompartment(Ctx, request)
+	return err
+}
+
+func (controller *coreController) ChangeImageCompartment(Ctx context.Context, ImageId string, CompartmentId string) error {
+	if !controller.initiated {
+		return errors.New("core Controller not initiated")
+	}
+	request := core.ChangeImageCompartmentRequest{
+		ImageId: common.String(ImageId),
+		ChangeImageCompartmentDetails: core.ChangeImageCompartmentDetails{
+			CompartmentId: common.String(CompartmentId),
+		},
+	}
+	_, err := controller.computeClient.ChangeImageCompartment(Ctx, request)
+	return err
+}
+
+func (controller *coreController) GetSubnet(Ctx context.Context, SubnetId string) (subnet *core.Subnet, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	response, err := controller.networkClient.GetSubnet(Ctx, core.GetSubnetRequest{SubnetId: common.String(SubnetId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Subnet, nil
+}
+
+func (controller *coreController) GetVcn(Ctx context.Context, VcnId string) (vcn *core.Vcn, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	response, err := controller.networkClient.GetVcn(Ctx, core.GetVcnRequest{VcnId: common.String(VcnId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Vcn, nil
+}
+
+func (controller *coreController) GetNetworkSecurityGroup(Ctx context.Context, NsgId string) (nsg *core.NetworkSecurityGroup, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	response, err := controller.networkClient.GetNetworkSecurityGroup(Ctx, core.GetNetworkSecurityGroupRequest{NetworkSecurityGroupId: common.String(NsgId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.NetworkSecurityGroup, nil
+}
+
+func (controller *coreController) ListPrivateIps(Ctx context.Context, VnicId string) (ips []core.PrivateIp, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.ListPrivateIpsRequest{VnicId: common.String(VnicId)}
+	for {
+		response, err := controller.networkClient.ListPrivateIps(Ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		ips = append(ips, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return ips, nil
+}
+
+// Returns public IP assigned to private IP, nil if there is none.
+func (controller *coreController) GetPublicIpByPrivateIpId(Ctx context.Context, PrivateIpId string) (ip *core.PublicIp, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.GetPublicIpByPrivateIpIdRequest{
+		GetPublicIpByPrivateIpIdDetails: core.GetPublicIpByPrivateIpIdDetails{PrivateIpId: common.String(PrivateIpId)},
+	}
+	response, err := controller.networkClient.GetPublicIpByPrivateIpId(Ctx, request)
+	if err != nil {
+		if serviceErr, ok := common.IsServiceError(err); ok && serviceErr.GetHTTPStatusCode() == 404 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &response.PublicIp, nil
+}
+
+func (controller *coreController) AttachVolume(Ctx context.Context, Details core.AttachVolumeDetails) (attachment core.VolumeAttachment, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	response, err := controller.computeClient.AttachVolume(Ctx, core.AttachVolumeRequest{AttachVolumeDetails: Details})
+	if err != nil {
+		return nil, err
+	}
+	return response.VolumeAttachment, nil
+}
+
+func (controller *coreController) DetachVolume(Ctx context.Context, AttachmentId string) error {
+	if !controller.initiated {
+		return errors.New("core Controller not initiated")
+	}
+	_, err := controller.computeClient.DetachVolume(Ctx, core.DetachVolumeRequest{VolumeAttachmentId: common.String(AttachmentId)})
+	return err
+}
+
+// Returns initial credentials of Windows instance. Credentials must never be logged.
+func (controller *coreController) GetWindowsInstanceInitialCredentials(Ctx context.Context, OcidId string) (credentials *core.InstanceCredentials, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.GetWindowsInstanceInitialCredentialsRequest{InstanceId: common.String(OcidId)}
+	response, err := controller.computeClient.GetWindowsInstanceInitialCredentials(Ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &response.InstanceCredentials, nil
+}
+
+func (controller *coreController) GetVcnTopology(Ctx context.Context, CompartmentId string, VcnId string) (topology *core.VcnTopology, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.GetVcnTopologyRequest{
+		CompartmentId: common.String(CompartmentId),
+		VcnId:         common.String(VcnId),
+	}
+	response, err := controller.networkClient.GetVcnTopology(Ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &response.VcnTopology, nil
+}
+
+func (controller *coreController) GetNetworkingTopology(Ctx context.Context, CompartmentId string) (topology *core.NetworkingTopology, err error) {
+	if !controller.initiated {
+		return nil, errors.New("core Controller not initiated")
+	}
+	request := core.GetNetworkingTopologyRequest{CompartmentId: common.String(CompartmentId)}
+	response, err := controller.networkClient.GetNetworkingTopology(Ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &response.NetworkingTopology, nil
+}
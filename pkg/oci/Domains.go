@@ -0,0 +1,128 @@
+package controller
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v52/core"
+)
+
+// Fault domain of availability domain with instances of the compartment placed in it.
+type FaultDomainUsage struct {
+	AvailabilityDomain string
+	FaultDomain        string
+	Instances          []core.Instance
+}
+
+// Group of instances sharing display name without numeric suffix (e.g. web-1, web-2).
+// IsSingleFaultDomain is set if workload has more than one instance and all of them are in the same fault domain.
+type WorkloadPlacement struct {
+	Name                string
+	Instances           []core.Instance
+	FaultDomains        []string
+	IsSingleFaultDomain bool
+}
+
+// Availability and fault domains of the region with distribution of compartment instances.
+type DomainsOverview struct {
+	FaultDomains []FaultDomainUsage
+	Workloads    []WorkloadPlacement
+}
+
+// Lists availability and fault domains of current region and distributes
+// not terminated instances of the compartment across them.
+func (controller *OCIController) GetDomainsOverview(compartmentId string) (overview DomainsOverview, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return overview, err
+	}
+	ads, err := controller.ListAvailabilityDomains()
+	if err != nil {
+		return overview, err
+	}
+	usageIdx := make(map[string]int)
+	for _, ad := range ads {
+		fds, err := controller.identityCtrl.ListFaultDomains(controller.context, tenancyId, *ad.Name)
+		if err != nil {
+			return overview, err
+		}
+		for _, fd := range fds {
+			usageIdx[*ad.Name+"/"+*fd.Name] = len(overview.FaultDomains)
+			overview.FaultDomains = append(overview.FaultDomains, FaultDomainUsage{AvailabilityDomain: *ad.Name, FaultDomain: *fd.Name})
+		}
+	}
+
+	instances, err := controller.listActiveInstances(compartmentId)
+	if err != nil {
+		return overview, err
+	}
+	workloadIdx := make(map[string]int)
+	for _, instance := range instances {
+		key := StringOrEmpty(instance.AvailabilityDomain) + "/" + StringOrEmpty(instance.FaultDomain)
+		if idx, ok := usageIdx[key]; ok {
+			overview.FaultDomains[idx].Instances = append(overview.FaultDomains[idx].Instances, instance)
+		}
+		name := workloadName(StringOrEmpty(instance.DisplayName))
+		idx, ok := workloadIdx[name]
+		if !ok {
+			idx = len(overview.Workloads)
+			workloadIdx[name] = idx
+			overview.Workloads = append(overview.Workloads, WorkloadPlacement{Name: name})
+		}
+		workload := &overview.Workloads[idx]
+		workload.Instances = append(workload.Instances, instance)
+		found := false
+		for _, fd := range workload.FaultDomains {
+			if fd == key {
+				found = true
+			}
+		}
+		if !found {
+			workload.FaultDomains = append(workload.FaultDomains, key)
+		}
+	}
+	for idx := range overview.Workloads {
+		workload := &overview.Workloads[idx]
+		workload.IsSingleFaultDomain = len(workload.Instances) > 1 && len(workload.FaultDomains) == 1
+	}
+	sort.SliceStable(overview.Workloads, func(i, j int) bool {
+		if overview.Workloads[i].IsSingleFaultDomain != overview.Workloads[j].IsSingleFaultDomain {
+			return overview.Workloads[i].IsSingleFaultDomain
+		}
+		return overview.Workloads[i].Name < overview.Workloads[j].Name
+	})
+	return overview, nil
+}
+
+func (controller *OCIController) listActiveInstances(compartmentId string) (instances []core.Instance, err error) {
+	page := ""
+	for {
+		items, nextPage, err := controller.coreCtrl.ListInstances(controller.context, compartmentId, 100, page,
+			core.ListInstancesSortByDisplayname, core.ListInstancesSortOrderAsc, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, instance := range items {
+			if instance.LifecycleState == core.InstanceLifecycleStateTerminated ||
+				instance.LifecycleState == core.InstanceLifecycleStateTerminating {
+				continue
+			}
+			instances = append(instances, instance)
+		}
+		if nextPage == "" {
+			break
+		}
+		page = nextPage
+	}
+	return instances, nil
+}
+
+// Strips numeric suffix with its separator from instance name, so web-01 and web-02 belong to workload web.
+func workloadName(displayName string) string {
+	name := strings.TrimRight(displayName, "0123456789")
+	name = strings.TrimRight(name, "-_. ")
+	if name == "" {
+		return displayName
+	}
+	return name
+}
@@ -0,0 +1,106 @@
+package controller
+
+import (
+	"sort"
+
+	"github.com/oracle/oci-go-sdk/v52/identity"
+)
+
+// Member of IAM group, MembershipId is needed to remove user from group.
+type GroupMember struct {
+	MembershipId string
+	UserId       string
+	UserName     string
+}
+
+// Group with its members.
+type GroupOverview struct {
+	Group   identity.Group
+	Members []GroupMember
+}
+
+// Lists all groups of the tenancy with their members.
+func (controller *OCIController) ListGroupsOverview() (overview []GroupOverview, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	groups, err := controller.identityCtrl.ListGroups(controller.context, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	users, err := controller.identityCtrl.ListUsers(controller.context, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	userNames := make(map[string]string)
+	for _, user := range users {
+		userNames[*user.Id] = *user.Name
+	}
+	overview = make([]GroupOverview, 0)
+	for _, group := range groups {
+		members, err := controller.listGroupMembers(tenancyId, *group.Id, userNames)
+		if err != nil {
+			return nil, err
+		}
+		overview = append(overview, GroupOverview{Group: group, Members: members})
+	}
+	return overview, nil
+}
+
+func (controller *OCIController) listGroupMembers(tenancyId string, groupId string, userNames map[string]string) (members []GroupMember, err error) {
+	memberships, err := controller.identityCtrl.ListUserGroupMemberships(controller.context, tenancyId, "", groupId)
+	if err != nil {
+		return nil, err
+	}
+	members = make([]GroupMember, 0)
+	for _, membership := range memberships {
+		name, ok := userNames[*membership.UserId]
+		if !ok {
+			name = *membership.UserId
+		}
+		members = append(members, GroupMember{
+			MembershipId: *membership.Id,
+			UserId:       *membership.UserId,
+			UserName:     name,
+		})
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		return members[i].UserName < members[j].UserName
+	})
+	return members, nil
+}
+
+func (controller *OCIController) ListUsers() (users []identity.User, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.ListUsers(controller.context, tenancyId)
+}
+
+func (controller *OCIController) ListDynamicGroups() (groups []identity.DynamicGroup, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.ListDynamicGroups(controller.context, tenancyId)
+}
+
+// Adds user to group, request is sent to home region of the tenancy.
+func (controller *OCIController) AddUserToGroup(userId string, groupId string) (membership *identity.UserGroupMembership, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.AddUserToGroup(controller.context, tenancyId, userId, groupId)
+}
+
+// Removes user from group, request is sent to home region of the tenancy.
+func (controller *OCIController) RemoveUserFromGroup(membershipId string) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	return controller.identityCtrl.RemoveUserFromGroup(controller.context, tenancyId, membershipId)
+}
@@ -3,14 +3,17 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/oracle/oci-go-sdk/v52/common"
 	"github.com/oracle/oci-go-sdk/v52/identity"
 )
 
 type identityController struct {
-	client    *identity.IdentityClient
-	initiated bool
+	client     *identity.IdentityClient
+	homeClient *identity.IdentityClient
+	initiated  bool
 }
 
 func newIdentityController() *identityController {
@@ -23,6 +26,7 @@ func newIdentityController() *identityController {
 func (controller *identityController) init(configProvider *common.ConfigurationProvider) error {
 	if c, err := identity.NewIdentityClientWithConfigurationProvider(*configProvider); err == nil {
 		controller.client = &c
+		controller.homeClient = nil
 		controller.initiated = true
 		return nil
 	} else {
@@ -95,3 +99,798 @@ func (controller *identityController) ListCompartments(ctx context.Context,
 
 	return response.Items, p, nil
 }
+
+func (controller *identityController) ListAvailabilityDomains(ctx context.Context, tenancyId string) (ads []identity.AvailabilityDomain, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	req := identity.ListAvailabilityDomainsRequest{CompartmentId: common.String(tenancyId)}
+	response, err := controller.client.ListAvailabilityDomains(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+func (controller *identityController) ListFaultDomains(ctx context.Context, compartmentId string, availabilityDomain string) (fds []identity.FaultDomain, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	req := identity.ListFaultDomainsRequest{
+		CompartmentId:      common.String(compartmentId),
+		AvailabilityDomain: common.String(availabilityDomain),
+	}
+	response, err := controller.client.ListFaultDomains(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+func (controller *identityController) ListUsers(ctx context.Context, tenancyId string) (users []identity.User, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListUsersRequest{CompartmentId: common.String(tenancyId)}
+	users = make([]identity.User, 0)
+	for {
+		response, err := controller.client.ListUsers(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return users, nil
+}
+
+func (controller *identityController) ListGroups(ctx context.Context, tenancyId string) (groups []identity.Group, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListGroupsRequest{CompartmentId: common.String(tenancyId)}
+	groups = make([]identity.Group, 0)
+	for {
+		response, err := controller.client.ListGroups(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return groups, nil
+}
+
+// Lists group memberships of user or members of group, empty userId or groupId is omitted.
+func (controller *identityController) ListUserGroupMemberships(ctx context.Context, tenancyId string, userId string, groupId string) (memberships []identity.UserGroupMembership, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListUserGroupMembershipsRequest{CompartmentId: common.String(tenancyId)}
+	if userId != "" {
+		request.UserId = common.String(userId)
+	}
+	if groupId != "" {
+		request.GroupId = common.String(groupId)
+	}
+	memberships = make([]identity.UserGroupMembership, 0)
+	for {
+		response, err := controller.client.ListUserGroupMemberships(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		memberships = append(memberships, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return memberships, nil
+}
+
+func (controller *identityController) ListApiKeys(ctx context.Context, userId string) (keys []identity.ApiKey, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	response, err := controller.client.ListApiKeys(ctx, identity.ListApiKeysRequest{UserId: common.String(userId)})
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+// Uploads public key in PEM format as API key of the user, request is sent to home region.
+func (controller *identityController) UploadApiKey(ctx context.Context, tenancyId string, userId string, publicKey string) (key *identity.ApiKey, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.UploadApiKey(ctx, identity.UploadApiKeyRequest{
+		UserId:              common.String(userId),
+		CreateApiKeyDetails: identity.CreateApiKeyDetails{Key: common.String(publicKey)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.ApiKey, nil
+}
+
+func (controller *identityController) DeleteApiKey(ctx context.Context, tenancyId string, userId string, fingerprint string) error {
+	if !controller.initiated {
+		return errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteApiKey(ctx, identity.DeleteApiKeyRequest{
+		UserId:      common.String(userId),
+		Fingerprint: common.String(fingerprint),
+	})
+	return err
+}
+
+func (controller *identityController) ListAuthTokens(ctx context.Context, userId string) (tokens []identity.AuthToken, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	response, err := controller.client.ListAuthTokens(ctx, identity.ListAuthTokensRequest{UserId: common.String(userId)})
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+func (controller *identityController) ListDynamicGroups(ctx context.Context, tenancyId string) (groups []identity.DynamicGroup, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListDynamicGroupsRequest{CompartmentId: common.String(tenancyId)}
+	groups = make([]identity.DynamicGroup, 0)
+	for {
+		response, err := controller.client.ListDynamicGroups(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return groups, nil
+}
+
+func (controller *identityController) ListRegionSubscriptions(ctx context.Context, tenancyId string) (subscriptions []identity.RegionSubscription, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	response, err := controller.client.ListRegionSubscriptions(ctx, identity.ListRegionSubscriptionsRequest{TenancyId: common.String(tenancyId)})
+	if err != nil {
+		return nil, err
+	}
+	return response.Items, nil
+}
+
+// IAM writes have to be sent to the home region of the tenancy,
+// returns client pointing to home region regardless of currently selected region.
+func (controller *identityController) getHomeRegionClient(ctx context.Context, tenancyId string) (*identity.IdentityClient, error) {
+	if controller.homeClient != nil {
+		return controller.homeClient, nil
+	}
+	subscriptions, err := controller.ListRegionSubscriptions(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	for _, subscription := range subscriptions {
+		if subscription.IsHomeRegion != nil && *subscription.IsHomeRegion {
+			client := *controller.client
+			client.SetRegion(*subscription.RegionName)
+			controller.homeClient = &client
+			return controller.homeClient, nil
+		}
+	}
+	return nil, errors.New("home region of tenancy not found")
+}
+
+func (controller *identityController) AddUserToGroup(ctx context.Context, tenancyId string, userId string, groupId string) (membership *identity.UserGroupMembership, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.AddUserToGroupRequest{AddUserToGroupDetails: identity.AddUserToGroupDetails{
+		UserId:  common.String(userId),
+		GroupId: common.String(groupId),
+	}}
+	response, err := client.AddUserToGroup(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &response.UserGroupMembership, nil
+}
+
+func (controller *identityController) RemoveUserFromGroup(ctx context.Context, tenancyId string, membershipId string) error {
+	if !controller.initiated {
+		return errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return err
+	}
+	_, err = client.RemoveUserFromGroup(ctx, identity.RemoveUserFromGroupRequest{UserGroupMembershipId: common.String(membershipId)})
+	return err
+}
+
+func (controller *identityController) ListPolicies(ctx context.Context, compartmentId string) (policies []identity.Policy, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListPoliciesRequest{CompartmentId: common.String(compartmentId)}
+	policies = make([]identity.Policy, 0)
+	for {
+		response, err := controller.client.ListPolicies(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return policies, nil
+}
+
+func (controller *identityController) ListTagNamespaces(ctx context.Context, compartmentId string, includeSubcompartments bool) (namespaces []identity.TagNamespaceSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListTagNamespacesRequest{
+		CompartmentId:          common.String(compartmentId),
+		IncludeSubcompartments: common.Bool(includeSubcompartments),
+	}
+	namespaces = make([]identity.TagNamespaceSummary, 0)
+	for {
+		response, err := controller.client.ListTagNamespaces(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return namespaces, nil
+}
+
+func (controller *identityController) ListTags(ctx context.Context, namespaceId string) (tags []identity.TagSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListTagsRequest{TagNamespaceId: common.String(namespaceId)}
+	tags = make([]identity.TagSummary, 0)
+	for {
+		response, err := controller.client.ListTags(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return tags, nil
+}
+
+func (controller *identityController) GetTag(ctx context.Context, namespaceId string, tagName string) (tag *identity.Tag, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	response, err := controller.client.GetTag(ctx, identity.GetTagRequest{TagNamespaceId: common.String(namespaceId), TagName: common.String(tagName)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Tag, nil
+}
+
+func (controller *identityController) ListTagDefaults(ctx context.Context, compartmentId string) (defaults []identity.TagDefaultSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListTagDefaultsRequest{CompartmentId: common.String(compartmentId)}
+	defaults = make([]identity.TagDefaultSummary, 0)
+	for {
+		response, err := controller.client.ListTagDefaults(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		defaults = append(defaults, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return defaults, nil
+}
+
+func (controller *identityController) CreateTagDefault(ctx context.Context, tenancyId string, details identity.CreateTagDefaultDetails) (tagDefault *identity.TagDefault, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.CreateTagDefault(ctx, identity.CreateTagDefaultRequest{CreateTagDefaultDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &response.TagDefault, nil
+}
+
+func (controller *identityController) DeleteTagDefault(ctx context.Context, tenancyId string, tagDefaultId string) error {
+	if !controller.initiated {
+		return errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteTagDefault(ctx, identity.DeleteTagDefaultRequest{TagDefaultId: common.String(tagDefaultId)})
+	return err
+}
+
+func (controller *identityController) ListCostTrackingTags(ctx context.Context, tenancyId string) (tags []identity.Tag, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	request := identity.ListCostTrackingTagsRequest{CompartmentId: common.String(tenancyId)}
+	tags = make([]identity.Tag, 0)
+	for {
+		response, err := controller.client.ListCostTrackingTags(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return tags, nil
+}
+
+func (controller *identityController) CreateTagNamespace(ctx context.Context, tenancyId string, details identity.CreateTagNamespaceDetails) (namespace *identity.TagNamespace, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.CreateTagNamespace(ctx, identity.CreateTagNamespaceRequest{CreateTagNamespaceDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &response.TagNamespace, nil
+}
+
+func (controller *identityController) UpdateTagNamespace(ctx context.Context, tenancyId string, namespaceId string, details identity.UpdateTagNamespaceDetails) (namespace *identity.TagNamespace, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.UpdateTagNamespace(ctx, identity.UpdateTagNamespaceRequest{TagNamespaceId: common.String(namespaceId), UpdateTagNamespaceDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &response.TagNamespace, nil
+}
+
+func (controller *identityController) CreateTag(ctx context.Context, tenancyId string, namespaceId string, details identity.CreateTagDetails) (tag *identity.Tag, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.CreateTag(ctx, identity.CreateTagRequest{TagNamespaceId: common.String(namespaceId), CreateTagDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Tag, nil
+}
+
+func (controller *identityController) UpdateTag(ctx context.Context, tenancyId string, namespaceId string, tagName string, details identity.UpdateTagDetails) (tag *identity.Tag, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.UpdateTag(ctx, identity.UpdateTagRequest{TagNamespaceId: common.String(namespaceId), TagName: common.String(tagName), UpdateTagDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Tag, nil
+}
+
+func (controller *identityController) UpdateCompartment(ctx context.Context, tenancyId string, compartmentId string, details identity.UpdateCompartmentDetails) (compartment *identity.Compartment, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.UpdateCompartment(ctx, identity.UpdateCompartmentRequest{CompartmentId: common.String(compartmentId), UpdateCompartmentDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Compartment, nil
+}
+
+func (controller *identityController) CreateCompartment(ctx context.Context, tenancyId string, details identity.CreateCompartmentDetails) (compartment *identity.Compartment, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.CreateCompartment(ctx, identity.CreateCompartmentRequest{CreateCompartmentDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Compartment, nil
+}
+
+// Moves compartment under target compartment, returns id of work request tracking the move.
+func (controller *identityController) MoveCompartment(ctx context.Context, tenancyId string, compartmentId string, targetCompartmentId string) (workRequestId string, err error) {
+	if !controller.initiated {
+		return "", errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return "", err
+	}
+	request := identity.MoveCompartmentRequest{
+		CompartmentId:          common.String(compartmentId),
+		MoveCompartmentDetails: identity.MoveCompartmentDetails{TargetCompartmentId: common.String(targetCompartmentId)},
+	}
+	response, err := client.MoveCompartment(ctx, request)
+	if err != nil {
+		return "", err
+	}
+	if response.OpcWorkRequestId == nil {
+		return "", nil
+	}
+	return *response.OpcWorkRequestId, nil
+}
+
+// Deletes compartment, returns id of work request tracking the deletion.
+func (controller *identityController) DeleteCompartment(ctx context.Context, tenancyId string, compartmentId string) (workRequestId string, err error) {
+	if !controller.initiated {
+		return "", errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return "", err
+	}
+	response, err := client.DeleteCompartment(ctx, identity.DeleteCompartmentRequest{CompartmentId: common.String(compartmentId)})
+	if err != nil {
+		return "", err
+	}
+	if response.OpcWorkRequestId == nil {
+		return "", nil
+	}
+	return *response.OpcWorkRequestId, nil
+}
+
+func (controller *identityController) RecoverCompartment(ctx context.Context, tenancyId string, compartmentId string) (compartment *identity.Compartment, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.RecoverCompartment(ctx, identity.RecoverCompartmentRequest{CompartmentId: common.String(compartmentId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Compartment, nil
+}
+
+// Returns IAM work request, IAM work requests are served by home region.
+func (controller *identityController) GetWorkRequest(ctx context.Context, tenancyId string, workRequestId string) (workRequest *identity.WorkRequest, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.GetWorkRequest(ctx, identity.GetWorkRequestRequest{WorkRequestId: common.String(workRequestId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.WorkRequest, nil
+}
+
+func (controller *identityController) GetIamWorkRequest(ctx context.Context, tenancyId string, workRequestId string) (workRequest *identity.IamWorkRequest, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.GetIamWorkRequest(ctx, identity.GetIamWorkRequestRequest{IamWorkRequestId: common.String(workRequestId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.IamWorkRequest, nil
+}
+
+func (controller *identityController) GetTaggingWorkRequest(ctx context.Context, tenancyId string, workRequestId string) (workRequest *identity.TaggingWorkRequest, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.GetTaggingWorkRequest(ctx, identity.GetTaggingWorkRequestRequest{WorkRequestId: common.String(workRequestId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.TaggingWorkRequest, nil
+}
+
+// Polls IAM work request every interval until it is finished.
+// Returns error if work request failed or was canceled.
+func (controller *identityController) WaitForWorkRequest(ctx context.Context,
+	tenancyId string,
+	workRequestId string,
+	interval time.Duration,
+	onPoll func(workRequest *identity.WorkRequest)) (workRequest *identity.WorkRequest, err error) {
+	for {
+		workRequest, err = controller.GetWorkRequest(ctx, tenancyId, workRequestId)
+		if err != nil {
+			return nil, err
+		}
+		if onPoll != nil {
+			onPoll(workRequest)
+		}
+		switch workRequest.Status {
+		case identity.WorkRequestStatusSucceeded:
+			return workRequest, nil
+		case identity.WorkRequestStatusFailed, identity.WorkRequestStatusCanceled:
+			return workRequest, fmt.Errorf("work request %s %s", workRequestId, workRequest.Status)
+		}
+		select {
+		case <-ctx.Done():
+			return workRequest, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+func (controller *identityController) GetTenancy(ctx context.Context, tenancyId string) (tenancy *identity.Tenancy, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	response, err := controller.client.GetTenancy(ctx, identity.GetTenancyRequest{TenancyId: common.String(tenancyId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Tenancy, nil
+}
+
+func (controller *identityController) CreateRegionSubscription(ctx context.Context, tenancyId string, regionKey string) (subscription *identity.RegionSubscription, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.CreateRegionSubscriptionRequest{
+		TenancyId:                       common.String(tenancyId),
+		CreateRegionSubscriptionDetails: identity.CreateRegionSubscriptionDetails{RegionKey: common.String(regionKey)},
+	}
+	response, err := client.CreateRegionSubscription(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &response.RegionSubscription, nil
+}
+
+// Lists compartment work requests (delete and move of compartments) of the compartment.
+func (controller *identityController) ListWorkRequests(ctx context.Context, tenancyId string, compartmentId string) (workRequests []identity.WorkRequestSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.ListWorkRequestsRequest{CompartmentId: common.String(compartmentId)}
+	workRequests = make([]identity.WorkRequestSummary, 0)
+	for {
+		response, err := client.ListWorkRequests(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		workRequests = append(workRequests, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return workRequests, nil
+}
+
+func (controller *identityController) ListIamWorkRequests(ctx context.Context, tenancyId string, compartmentId string) (workRequests []identity.IamWorkRequestSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.ListIamWorkRequestsRequest{CompartmentId: common.String(compartmentId)}
+	workRequests = make([]identity.IamWorkRequestSummary, 0)
+	for {
+		response, err := client.ListIamWorkRequests(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		workRequests = append(workRequests, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return workRequests, nil
+}
+
+func (controller *identityController) ListIamWorkRequestErrors(ctx context.Context, tenancyId string, workRequestId string) (workRequestErrors []identity.IamWorkRequestErrorSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.ListIamWorkRequestErrorsRequest{IamWorkRequestId: common.String(workRequestId)}
+	workRequestErrors = make([]identity.IamWorkRequestErrorSummary, 0)
+	for {
+		response, err := client.ListIamWorkRequestErrors(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		workRequestErrors = append(workRequestErrors, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return workRequestErrors, nil
+}
+
+func (controller *identityController) ListIamWorkRequestLogs(ctx context.Context, tenancyId string, workRequestId string) (logs []identity.IamWorkRequestLogSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.ListIamWorkRequestLogsRequest{IamWorkRequestId: common.String(workRequestId)}
+	logs = make([]identity.IamWorkRequestLogSummary, 0)
+	for {
+		response, err := client.ListIamWorkRequestLogs(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return logs, nil
+}
+
+func (controller *identityController) ListTaggingWorkRequests(ctx context.Context, tenancyId string, compartmentId string) (workRequests []identity.TaggingWorkRequestSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.ListTaggingWorkRequestsRequest{CompartmentId: common.String(compartmentId)}
+	workRequests = make([]identity.TaggingWorkRequestSummary, 0)
+	for {
+		response, err := client.ListTaggingWorkRequests(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		workRequests = append(workRequests, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return workRequests, nil
+}
+
+func (controller *identityController) ListTaggingWorkRequestErrors(ctx context.Context, tenancyId string, workRequestId string) (workRequestErrors []identity.TaggingWorkRequestErrorSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.ListTaggingWorkRequestErrorsRequest{WorkRequestId: common.String(workRequestId)}
+	workRequestErrors = make([]identity.TaggingWorkRequestErrorSummary, 0)
+	for {
+		response, err := client.ListTaggingWorkRequestErrors(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		workRequestErrors = append(workRequestErrors, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return workRequestErrors, nil
+}
+
+func (controller *identityController) ListTaggingWorkRequestLogs(ctx context.Context, tenancyId string, workRequestId string) (logs []identity.TaggingWorkRequestLogSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("identity Controller not initiated")
+	}
+	client, err := controller.getHomeRegionClient(ctx, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	request := identity.ListTaggingWorkRequestLogsRequest{WorkRequestId: common.String(workRequestId)}
+	logs = make([]identity.TaggingWorkRequestLogSummary, 0)
+	for {
+		response, err := client.ListTaggingWorkRequestLogs(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return logs, nil
+}
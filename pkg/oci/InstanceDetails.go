@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"fmt"
+
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/core"
+)
+
+// Volume attachment types supported by AttachVolume.
+const (
+	AttachmentParavirtualized = "paravirtualized"
+	AttachmentIscsi           = "iscsi"
+)
+
+// IP address of VNIC with public IP assigned to it (empty if there is none).
+type VnicIp struct {
+	PrivateIp string
+	PublicIp  string
+	IsPrimary bool
+}
+
+// VNIC attached to instance with names of related network resources.
+type VnicDetails struct {
+	Vnic       core.Vnic
+	Fqdn       string
+	SubnetName string
+	SubnetCidr string
+	VcnName    string
+	Ips        []VnicIp
+	NsgNames   []string
+}
+
+// Lists VNICs of the instance with IPs, subnet, VCN and network security groups.
+func (controller *OCIController) ListInstanceNetworking(compartmentId string, instanceId string) (details []VnicDetails, err error) {
+	vnics, err := controller.ListInstanceVnics(compartmentId, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	for _, vnic := range vnics {
+		detail := VnicDetails{Vnic: vnic}
+		if vnic.SubnetId != nil {
+			subnet, err := controller.coreCtrl.GetSubnet(controller.context, *vnic.SubnetId)
+			if err != nil {
+				return nil, err
+			}
+			detail.SubnetName = *subnet.DisplayName
+			detail.SubnetCidr = *subnet.CidrBlock
+			if vnic.HostnameLabel != nil && subnet.SubnetDomainName != nil {
+				detail.Fqdn = *vnic.HostnameLabel + "." + *subnet.SubnetDomainName
+			}
+			vcn, err := controller.coreCtrl.GetVcn(controller.context, *subnet.VcnId)
+			if err != nil {
+				return nil, err
+			}
+			detail.VcnName = *vcn.DisplayName
+		}
+		privateIps, err := controller.coreCtrl.ListPrivateIps(controller.context, *vnic.Id)
+		if err != nil {
+			return nil, err
+		}
+		for _, ip := range privateIps {
+			vnicIp := VnicIp{PrivateIp: *ip.IpAddress, IsPrimary: ip.IsPrimary != nil && *ip.IsPrimary}
+			if vnicIp.IsPrimary && vnic.PublicIp != nil {
+				vnicIp.PublicIp = *vnic.PublicIp
+			} else if !vnicIp.IsPrimary {
+				publicIp, err := controller.coreCtrl.GetPublicIpByPrivateIpId(controller.context, *ip.Id)
+				if err != nil {
+					return nil, err
+				}
+				if publicIp != nil && publicIp.IpAddress != nil {
+					vnicIp.PublicIp = *publicIp.IpAddress
+				}
+			}
+			detail.Ips = append(detail.Ips, vnicIp)
+		}
+		for _, nsgId := range vnic.NsgIds {
+			nsg, err := controller.coreCtrl.GetNetworkSecurityGroup(controller.context, nsgId)
+			if err != nil {
+				return nil, err
+			}
+			detail.NsgNames = append(detail.NsgNames, *nsg.DisplayName)
+		}
+		details = append(details, detail)
+	}
+	return details, nil
+}
+
+// Boot or block volume attached to instance.
+type InstanceVolume struct {
+	AttachmentId    string
+	VolumeId        string
+	Name            string
+	IsBoot          bool
+	SizeInGBs       int64
+	VpusPerGB       int64
+	AttachmentType  string
+	AttachmentState string
+	Device          string
+	IsReadOnly      bool
+	IscsiTarget     string
+}
+
+// Lists boot volume and block volumes attached to the instance.
+func (controller *OCIController) ListInstanceStorage(compartmentId string, availabilityDomain string, instanceId string) (volumes []InstanceVolume, err error) {
+	bootAttachments, err := controller.ListBootVolumeAttachments(compartmentId, availabilityDomain, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	for _, att := range bootAttachments {
+		if att.LifecycleState == core.BootVolumeAttachmentLifecycleStateDetached {
+			continue
+		}
+		volume := InstanceVolume{
+			AttachmentId:    *att.Id,
+			VolumeId:        *att.BootVolumeId,
+			IsBoot:          true,
+			AttachmentType:  "boot",
+			AttachmentState: string(att.LifecycleState),
+		}
+		bootVolume, err := controller.coreCtrl.GetBootVolume(controller.context, *att.BootVolumeId)
+		if err != nil {
+			return nil, err
+		}
+		volume.Name = *bootVolume.DisplayName
+		volume.SizeInGBs = Int64OrZero(bootVolume.SizeInGBs)
+		volume.VpusPerGB = Int64OrZero(bootVolume.VpusPerGB)
+		volumes = append(volumes, volume)
+	}
+
+	attachments, err := controller.ListVolumeAttachments(compartmentId, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	for _, att := range attachments {
+		if att.GetLifecycleState() == core.VolumeAttachmentLifecycleStateDetached {
+			continue
+		}
+		volume := InstanceVolume{
+			AttachmentId:    *att.GetId(),
+			VolumeId:        *att.GetVolumeId(),
+			AttachmentState: string(att.GetLifecycleState()),
+			IsReadOnly:      att.GetIsReadOnly() != nil && *att.GetIsReadOnly(),
+		}
+		if att.GetDevice() != nil {
+			volume.Device = *att.GetDevice()
+		}
+		switch a := att.(type) {
+		case core.IScsiVolumeAttachment:
+			volume.AttachmentType = AttachmentIscsi
+			volume.IscsiTarget = fmt.Sprintf("%s %s:%d", *a.Iqn, *a.Ipv4, *a.Port)
+		case core.ParavirtualizedVolumeAttachment:
+			volume.AttachmentType = AttachmentParavirtualized
+		case core.EmulatedVolumeAttachment:
+			volume.AttachmentType = "emulated"
+		default:
+			volume.AttachmentType = "unknown"
+		}
+		blockVolume, err := controller.coreCtrl.GetVolume(controller.context, *att.GetVolumeId())
+		if err != nil {
+			return nil, err
+		}
+		volume.Name = *blockVolume.DisplayName
+		volume.SizeInGBs = Int64OrZero(blockVolume.SizeInGBs)
+		volume.VpusPerGB = Int64OrZero(blockVolume.VpusPerGB)
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+// Lists available volumes from availability domain of the instance which can be attached to it.
+func (controller *OCIController) ListAttachableVolumes(compartmentId string, availabilityDomain string) (volumes []core.Volume, err error) {
+	all, err := controller.coreCtrl.ListVolumes(controller.context, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range all {
+		if v.LifecycleState == core.VolumeLifecycleStateAvailable && *v.AvailabilityDomain == availabilityDomain {
+			volumes = append(volumes, v)
+		}
+	}
+	return volumes, nil
+}
+
+func (controller *OCIController) AttachVolume(instanceId string, volumeId string, attachmentType string, readOnly bool) error {
+	var details core.AttachVolumeDetails
+	switch attachmentType {
+	case AttachmentIscsi:
+		details = core.AttachIScsiVolumeDetails{
+			InstanceId: common.String(instanceId),
+			VolumeId:   common.String(volumeId),
+			IsReadOnly: common.Bool(readOnly),
+		}
+	case AttachmentParavirtualized:
+		details = core.AttachParavirtualizedVolumeDetails{
+			InstanceId: common.String(instanceId),
+			VolumeId:   common.String(volumeId),
+			IsReadOnly: common.Bool(readOnly),
+		}
+	default:
+		return fmt.Errorf("attachment type %s is not supported", attachmentType)
+	}
+	_, err := controller.coreCtrl.AttachVolume(controller.context, details)
+	return err
+}
+
+func (controller *OCIController) DetachVolume(attachmentId string) error {
+	return controller.coreCtrl.DetachVolume(controller.context, attachmentId)
+}
+
+// Dereferences optional SDK value, nil is returned as zero.
+func Int64OrZero(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
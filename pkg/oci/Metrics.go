@@ -0,0 +1,146 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/monitoring"
+)
+
+// Data points of one metric stream, Points are pairs of unix timestamp and value sorted by time.
+type MetricSeries struct {
+	Name       string
+	Dimensions map[string]string
+	Points     [][]float64
+}
+
+// Returns short label of series made of its metric name and dimensions.
+func (series *MetricSeries) Label() string {
+	keys := make([]string, 0, len(series.Dimensions))
+	for key := range series.Dimensions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var dims []string
+	for _, key := range keys {
+		dims = append(dims, fmt.Sprintf("%s=%s", key, series.Dimensions[key]))
+	}
+	if len(dims) == 0 {
+		return series.Name
+	}
+	return fmt.Sprintf("%s{%s}", series.Name, strings.Join(dims, ", "))
+}
+
+// Runs MQL query in namespace and returns every metric stream it matches.
+func (controller *OCIController) QueryMetrics(compartmentId string, namespace string, query string, start time.Time, end time.Time) (series []MetricSeries, err error) {
+	items, err := controller.monitoringCtrl.SummarizeMetricsData(controller.context, compartmentId, namespace, query, start, end)
+	if err != nil {
+		return nil, err
+	}
+	series = make([]MetricSeries, 0)
+	for _, item := range items {
+		s := MetricSeries{Name: StringOrEmpty(item.Name), Dimensions: item.Dimensions}
+		for _, point := range item.AggregatedDatapoints {
+			if point.Timestamp == nil || point.Value == nil {
+				continue
+			}
+			s.Points = append(s.Points, []float64{float64(point.Timestamp.Time.Unix()), *point.Value})
+		}
+		sort.Slice(s.Points, func(i, j int) bool {
+			return s.Points[i][0] < s.Points[j][0]
+		})
+		series = append(series, s)
+	}
+	return series, nil
+}
+
+// Returns namespaces of metrics posted to compartment.
+func (controller *OCIController) ListMetricNamespaces(compartmentId string) (namespaces []string, err error) {
+	metrics, err := controller.monitoringCtrl.ListMetrics(controller.context, compartmentId, monitoring.ListMetricsDetails{
+		GroupBy:   []string{"namespace"},
+		SortBy:    monitoring.ListMetricsDetailsSortByNamespace,
+		SortOrder: monitoring.ListMetricsDetailsSortOrderAsc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	namespaces = make([]string, 0, len(metrics))
+	for _, metric := range metrics {
+		if metric.Namespace != nil {
+			namespaces = append(namespaces, *metric.Namespace)
+		}
+	}
+	return namespaces, nil
+}
+
+// Returns names of metrics of namespace posted to compartment.
+func (controller *OCIController) ListMetricNames(compartmentId string, namespace string) (names []string, err error) {
+	metrics, err := controller.monitoringCtrl.ListMetrics(controller.context, compartmentId, monitoring.ListMetricsDetails{
+		Namespace: common.String(namespace),
+		GroupBy:   []string{"name"},
+		SortBy:    monitoring.ListMetricsDetailsSortByName,
+		SortOrder: monitoring.ListMetricsDetailsSortOrderAsc,
+	})
+	if err != nil {
+		return nil, err
+	}
+	names = make([]string, 0, len(metrics))
+	for _, metric := range metrics {
+		if metric.Name != nil {
+			names = append(names, *metric.Name)
+		}
+	}
+	return names, nil
+}
+
+// Returns dimensions of metric with their distinct values, both sorted.
+func (controller *OCIController) ListMetricDimensions(compartmentId string, namespace string, name string) (dimensions map[string][]string, err error) {
+	metrics, err := controller.monitoringCtrl.ListMetrics(controller.context, compartmentId, monitoring.ListMetricsDetails{
+		Namespace: common.String(namespace),
+		Name:      common.String(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]map[string]bool)
+	for _, metric := range metrics {
+		for key, value := range metric.Dimensions {
+			if _, ok := seen[key]; !ok {
+				seen[key] = make(map[string]bool)
+			}
+			seen[key][value] = true
+		}
+	}
+	dimensions = make(map[string][]string)
+	for key, values := range seen {
+		for value := range values {
+			dimensions[key] = append(dimensions[key], value)
+		}
+		sort.Strings(dimensions[key])
+	}
+	return dimensions, nil
+}
+
+// Returns metric of resource in namespace aggregated by statistic over interval between start and end.
+// Statistic is one of mean, max, min, sum, count or percentile, percentile is used only by percentile statistic.
+// With grouped all streams of the resource (e.g. per disk or VNIC) are aggregated by the statistic into one.
+func (controller *OCIController) ResourceMetric(compartmentId string,
+	namespace string,
+	resourceId string,
+	metric string,
+	interval string,
+	grouped bool,
+	statistic string,
+	percentile float64,
+	start time.Time,
+	end time.Time) (map[float64]float64, error) {
+	grouping := ""
+	if grouped {
+		grouping = "grouping()."
+	}
+	query := fmt.Sprintf("%s[%s]{resourceId=%s}.%s%s", metric, interval, resourceId, grouping, MetricsStatisticString(statistic, percentile))
+	return controller.monitoringCtrl.getMetricsByQuery(controller.context, namespace, query, compartmentId, start, end)
+}
@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -36,6 +37,14 @@ func JoinMetricsQueryString(metric string, interval string, instanceId string, g
 	return fmt.Sprintf("%s[%s]{resourceId=%s}.%s()", metric, interval, instanceId, groupingFunction)
 }
 
+// Returns statistic part of metrics query, percentile is fraction, e.g. percentile(0.95).
+func MetricsStatisticString(statistic string, percentile float64) string {
+	if statistic == "percentile" {
+		return fmt.Sprintf("percentile(%g)", percentile)
+	}
+	return statistic + "()"
+}
+
 // metric[interval]{resourceId="resourceId"}.groupingfunction.statistic
 // metric : CpuUtilization, MemoryUtilization
 // interval : 1-59m 1-23h
@@ -57,41 +66,33 @@ func (controller *monitoringController) getMetrics(
 	stopDate time.Time) (map[float64]float64, error) {
 	query := JoinMetricsQueryString(metric, interval, instanceId, groupingFunction)
 
-	return controller.getMetricsByQuery(ctx, query, compartmentId, startDate, stopDate)
+	return controller.getMetricsByQuery(ctx, "oci_computeagent", query, compartmentId, startDate, stopDate)
 
 }
 
+// Returns data of query in namespace, query has to match exactly one metric stream,
+// metrics with more streams per resource have to be aggregated by grouping().
 func (controller *monitoringController) getMetricsByQuery(
 	ctx context.Context,
+	namespace string,
 	query string,
 	compartmentId string,
 	startDate time.Time,
 	stopDate time.Time) (map[float64]float64, error) {
-	req := monitoring.SummarizeMetricsDataRequest{
-		CompartmentId: common.String(compartmentId),
-		SummarizeMetricsDataDetails: monitoring.SummarizeMetricsDataDetails{
-			EndTime:   &common.SDKTime{Time: stopDate},
-			Namespace: common.String("oci_computeagent"),
-			Query:     common.String(query),
-			StartTime: &common.SDKTime{Time: startDate}}}
-
-	// Send the request using the service client
-	resp, err := controller.client.SummarizeMetricsData(context.Background(), req)
-
+	items, err := controller.SummarizeMetricsData(ctx, compartmentId, namespace, query, startDate, stopDate)
 	if err != nil {
 		return nil, err
 	}
-
-	if len(resp.Items) > 1 {
-		return nil, fmt.Errorf("number of Items returned should be 1, got %d", len(resp.Items))
+	if len(items) > 1 {
+		return nil, fmt.Errorf("number of Items returned should be 1, got %d", len(items))
 	}
-	if len(resp.Items) == 0 {
+	if len(items) == 0 {
 		return nil, fmt.Errorf("no data found")
 	}
 
 	result := make(map[float64]float64)
 
-	for _, item := range resp.Items[0].AggregatedDatapoints {
+	for _, item := range items[0].AggregatedDatapoints {
 		t := float64(item.Timestamp.Time.Unix())
 		v := item.Value
 		result[t] = *v
@@ -99,4 +100,191 @@ func (controller *monitoringController) getMetricsByQuery(
 	return result, nil
 }
 
+func (controller *monitoringController) ListAlarms(ctx context.Context, compartmentId string) (alarms []monitoring.AlarmSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	request := monitoring.ListAlarmsRequest{
+		CompartmentId: common.String(compartmentId),
+		SortBy:        monitoring.ListAlarmsSortByDisplayname,
+		SortOrder:     monitoring.ListAlarmsSortOrderAsc,
+	}
+	alarms = make([]monitoring.AlarmSummary, 0)
+	for {
+		response, err := controller.client.ListAlarms(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		alarms = append(alarms, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return alarms, nil
+}
+
+func (controller *monitoringController) ListAlarmsStatus(ctx context.Context, compartmentId string) (statuses []monitoring.AlarmStatusSummary, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	request := monitoring.ListAlarmsStatusRequest{CompartmentId: common.String(compartmentId)}
+	statuses = make([]monitoring.AlarmStatusSummary, 0)
+	for {
+		response, err := controller.client.ListAlarmsStatus(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return statuses, nil
+}
+
+func (controller *monitoringController) GetAlarmHistory(ctx context.Context,
+	alarmId string,
+	historyType monitoring.GetAlarmHistoryAlarmHistorytypeEnum,
+	since time.Time) (entries []monitoring.AlarmHistoryEntry, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	request := monitoring.GetAlarmHistoryRequest{
+		AlarmId:                       common.String(alarmId),
+		AlarmHistorytype:              historyType,
+		TimestampGreaterThanOrEqualTo: &common.SDKTime{Time: since},
+	}
+	entries = make([]monitoring.AlarmHistoryEntry, 0)
+	for {
+		response, err := controller.client.GetAlarmHistory(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, response.Entries...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return entries, nil
+}
+
+// Suppresses notifications of the alarm from now until given time.
+func (controller *monitoringController) SuppressAlarm(ctx context.Context, alarmId string, until time.Time, description string) (alarm *monitoring.Alarm, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	suppression := monitoring.Suppression{
+		TimeSuppressFrom:  &common.SDKTime{Time: time.Now()},
+		TimeSuppressUntil: &common.SDKTime{Time: until},
+	}
+	if description != "" {
+		suppression.Description = common.String(description)
+	}
+	request := monitoring.UpdateAlarmRequest{
+		AlarmId:            common.String(alarmId),
+		UpdateAlarmDetails: monitoring.UpdateAlarmDetails{Suppression: &suppression},
+	}
+	response, err := controller.client.UpdateAlarm(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return &response.Alarm, nil
+}
+
+func (controller *monitoringController) RemoveAlarmSuppression(ctx context.Context, alarmId string) error {
+	if !controller.initiated {
+		return errors.New("monitoring Controller not initiated")
+	}
+	_, err := controller.client.RemoveAlarmSuppression(ctx, monitoring.RemoveAlarmSuppressionRequest{AlarmId: common.String(alarmId)})
+	return err
+}
+
+// Returns data of all metric streams matching query.
+func (controller *monitoringController) SummarizeMetricsData(ctx context.Context,
+	compartmentId string,
+	namespace string,
+	query string,
+	startDate time.Time,
+	stopDate time.Time) (items []monitoring.MetricData, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	req := monitoring.SummarizeMetricsDataRequest{
+		CompartmentId: common.String(compartmentId),
+		SummarizeMetricsDataDetails: monitoring.SummarizeMetricsDataDetails{
+			Namespace: common.String(namespace),
+			Query:     common.String(query),
+			StartTime: &common.SDKTime{Time: startDate},
+			EndTime:   &common.SDKTime{Time: stopDate}}}
+	resp, err := controller.client.SummarizeMetricsData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Items, nil
+}
+
+func (controller *monitoringController) GetAlarm(ctx context.Context, alarmId string) (alarm *monitoring.Alarm, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	response, err := controller.client.GetAlarm(ctx, monitoring.GetAlarmRequest{AlarmId: common.String(alarmId)})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Alarm, nil
+}
+
+func (controller *monitoringController) CreateAlarm(ctx context.Context, details monitoring.CreateAlarmDetails) (alarm *monitoring.Alarm, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	response, err := controller.client.CreateAlarm(ctx, monitoring.CreateAlarmRequest{CreateAlarmDetails: details})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Alarm, nil
+}
+
+func (controller *monitoringController) UpdateAlarm(ctx context.Context, alarmId string, details monitoring.UpdateAlarmDetails) (alarm *monitoring.Alarm, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	response, err := controller.client.UpdateAlarm(ctx, monitoring.UpdateAlarmRequest{
+		AlarmId:            common.String(alarmId),
+		UpdateAlarmDetails: details,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &response.Alarm, nil
+}
+
+// Lists metric definitions of compartment matching details, groupBy of details can be used
+// to list only distinct namespaces or names.
+func (controller *monitoringController) ListMetrics(ctx context.Context, compartmentId string, details monitoring.ListMetricsDetails) (metrics []monitoring.Metric, err error) {
+	if !controller.initiated {
+		return nil, errors.New("monitoring Controller not initiated")
+	}
+	request := monitoring.ListMetricsRequest{
+		CompartmentId:      common.String(compartmentId),
+		ListMetricsDetails: details,
+	}
+	metrics = make([]monitoring.Metric, 0)
+	for {
+		response, err := controller.client.ListMetrics(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, response.Items...)
+		if response.OpcNextPage == nil {
+			break
+		}
+		request.Page = response.OpcNextPage
+	}
+	return metrics, nil
+}
+
 // TODO
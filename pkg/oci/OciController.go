@@ -2,6 +2,7 @@ package controller
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/oracle/oci-go-sdk/v52/common"
@@ -17,6 +18,10 @@ type OCIController struct {
 	identityCtrl                  *identityController
 	coreCtrl                      *coreController
 	monitoringCtrl                *monitoringController
+	workRequestCtrl               *workRequestController
+	// operating system of image does not change, it is looked up once per image
+	windowsImages   map[string]bool
+	windowsImagesMu sync.Mutex
 }
 
 func NewOCIControllerDefault() *OCIController {
@@ -25,12 +30,14 @@ func NewOCIControllerDefault() *OCIController {
 
 func NewOCIControler(filePath string, profile string) *OCIController {
 	res := OCIController{
-		configFilePath: "",
-		configProfile:  "",
-		identityCtrl:   newIdentityController(),
-		coreCtrl:       newCoreController(),
-		monitoringCtrl: newMonitoringController(),
-		configProvider: nil,
+		configFilePath:  "",
+		configProfile:   "",
+		identityCtrl:    newIdentityController(),
+		coreCtrl:        newCoreController(),
+		monitoringCtrl:  newMonitoringController(),
+		workRequestCtrl: newWorkRequestController(),
+		configProvider:  nil,
+		windowsImages:   make(map[string]bool),
 	}
 	res.context, res.cancelContext = context.WithCancel(context.Background())
 	res.ReloadConfig(filePath, profile)
@@ -69,8 +76,9 @@ func (controller *OCIController) ReloadConfig(filePath string, profile string) e
 
 func (controller *OCIController) ChangeRegion(region string) {
 	controller.identityCtrl.client.SetRegion(region)
-	controller.coreCtrl.computeClient.SetRegion(region)
+	controller.coreCtrl.setRegion(region)
 	controller.monitoringCtrl.client.SetRegion(region)
+	controller.workRequestCtrl.client.SetRegion(region)
 }
 
 func (controller *OCIController) reoladControllers() error {
@@ -85,6 +93,10 @@ func (controller *OCIController) reoladControllers() error {
 	if err := controller.monitoringCtrl.init(controller.configProvider); err != nil {
 		return err
 	}
+
+	if err := controller.workRequestCtrl.init(controller.configProvider); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -124,6 +136,105 @@ func (controller *OCIController) ListCompartments(compartmentId string,
 	return controller.identityCtrl.ListCompartments(controller.context, compartmentId, limit, accessLevel, sortBy, sortOrder, lifecycleState, page)
 }
 
+func (controller *OCIController) ListAvailabilityDomains() (ads []identity.AvailabilityDomain, err error) {
+	confPrv := *(controller.configProvider)
+	tenancyID, err := confPrv.TenancyOCID()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.ListAvailabilityDomains(controller.context, tenancyID)
+}
+
+func (controller *OCIController) ListShapes(compartmentId string, availabilityDomain string) (shapes []core.Shape, err error) {
+	return controller.coreCtrl.ListShapes(controller.context, compartmentId, availabilityDomain)
+}
+
+func (controller *OCIController) ListImages(compartmentId string, shape string) (images []core.Image, err error) {
+	return controller.coreCtrl.ListImages(controller.context, compartmentId, shape)
+}
+
+func (controller *OCIController) ListSubnets(compartmentId string) (subnets []core.Subnet, err error) {
+	return controller.coreCtrl.ListSubnets(controller.context, compartmentId)
+}
+
+func (controller *OCIController) LaunchInstance(details core.LaunchInstanceDetails) (instance *core.Instance, err error) {
+	return controller.coreCtrl.LaunchInstance(controller.context, details)
+}
+
+// Polls instance until it reaches state, ctx allows caller to stop polling early.
+func (controller *OCIController) WaitForInstanceState(ctx context.Context,
+	instanceId string,
+	state core.InstanceLifecycleStateEnum,
+	onPoll func(instance *core.Instance)) (instance *core.Instance, err error) {
+	return controller.coreCtrl.WaitForInstanceState(ctx, instanceId, state, 10*time.Second, onPoll)
+}
+
+func (controller *OCIController) TerminateInstance(instanceId string, preserveBootVolume bool) error {
+	return controller.coreCtrl.TerminateInstance(controller.context, instanceId, preserveBootVolume)
+}
+
+func (controller *OCIController) ListVolumeAttachments(compartmentId string, instanceId string) (attachments []core.VolumeAttachment, err error) {
+	return controller.coreCtrl.ListVolumeAttachments(controller.context, compartmentId, instanceId)
+}
+
+func (controller *OCIController) ListBootVolumeAttachments(compartmentId string, availabilityDomain string, instanceId string) (attachments []core.BootVolumeAttachment, err error) {
+	return controller.coreCtrl.ListBootVolumeAttachments(controller.context, compartmentId, availabilityDomain, instanceId)
+}
+
+// Returns attached VNICs of the instance.
+func (controller *OCIController) ListInstanceVnics(compartmentId string, instanceId string) (vnics []core.Vnic, err error) {
+	attachments, err := controller.coreCtrl.ListVnicAttachments(controller.context, compartmentId, instanceId)
+	if err != nil {
+		return nil, err
+	}
+	for _, att := range attachments {
+		if att.LifecycleState != core.VnicAttachmentLifecycleStateAttached || att.VnicId == nil {
+			continue
+		}
+		vnic, err := controller.coreCtrl.GetVnic(controller.context, *att.VnicId)
+		if err != nil {
+			return nil, err
+		}
+		vnics = append(vnics, *vnic)
+	}
+	return vnics, nil
+}
+
+func (controller *OCIController) UpdateInstance(instanceId string, details core.UpdateInstanceDetails) (instance *core.Instance, err error) {
+	return controller.coreCtrl.UpdateInstance(controller.context, instanceId, details)
+}
+
+func (controller *OCIController) GetWindowsInstanceInitialCredentials(instanceId string) (credentials *core.InstanceCredentials, err error) {
+	return controller.coreCtrl.GetWindowsInstanceInitialCredentials(controller.context, instanceId)
+}
+
+// Checks operating system of the image instance was launched from, result is cached per image.
+// Instances launched from boot volume are not recognized as Windows.
+func (controller *OCIController) IsWindowsInstance(instance *core.Instance) (bool, error) {
+	imageId := instance.ImageId
+	if source, ok := instance.SourceDetails.(core.InstanceSourceViaImageDetails); ok {
+		imageId = source.ImageId
+	}
+	if imageId == nil {
+		return false, nil
+	}
+	controller.windowsImagesMu.Lock()
+	windows, ok := controller.windowsImages[*imageId]
+	controller.windowsImagesMu.Unlock()
+	if ok {
+		return windows, nil
+	}
+	image, err := controller.coreCtrl.GetImage(controller.context, *imageId)
+	if err != nil {
+		return false, err
+	}
+	windows = image.OperatingSystem != nil && *image.OperatingSystem == "Windows"
+	controller.windowsImagesMu.Lock()
+	controller.windowsImages[*imageId] = windows
+	controller.windowsImagesMu.Unlock()
+	return windows, nil
+}
+
 func (controller *OCIController) ExecuteInstanceAction(instanceOCID *string, action core.InstanceActionActionEnum) (instance *core.Instance, err error) {
 	return controller.coreCtrl.InstanceAction(controller.context, instanceOCID, action)
 }
@@ -140,3 +251,11 @@ func (controller *OCIController) MemoryUtilization10mLast24hMax(compartmentId st
 	return controller.monitoringCtrl.getMetrics(
 		controller.context, "MemoryUtilization", "10m", instanceId, "max", compartmentId, time.Now().AddDate(0, 0, -1), time.Now())
 }
+
+// Dereferences optional SDK value, nil is returned as empty string.
+func StringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v52/identity"
+)
+
+// Policy with path of its compartment, e.g. root/network/prod.
+type PolicySummary struct {
+	Policy          identity.Policy
+	CompartmentPath string
+}
+
+// Returns map of compartment OCID to its path starting from root compartment of the tenancy.
+func compartmentPaths(tenancyId string, compartments []identity.Compartment) map[string]string {
+	byId := make(map[string]identity.Compartment)
+	for _, comp := range compartments {
+		byId[*comp.Id] = comp
+	}
+	paths := map[string]string{tenancyId: "root"}
+	var pathOf func(id string) string
+	pathOf = func(id string) string {
+		if path, ok := paths[id]; ok {
+			return path
+		}
+		comp, ok := byId[id]
+		if !ok {
+			return id
+		}
+		path := pathOf(*comp.CompartmentId) + "/" + *comp.Name
+		paths[id] = path
+		return path
+	}
+	for _, comp := range compartments {
+		pathOf(*comp.Id)
+	}
+	return paths
+}
+
+// Lists policies of root compartment and all accessible compartments of the tenancy,
+// sorted by compartment path and policy name.
+func (controller *OCIController) ListAllPolicies() (policies []PolicySummary, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	compartments, err := controller.identityCtrl.ListAllCompartments(controller.context, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	paths := compartmentPaths(tenancyId, compartments)
+	ids := []string{tenancyId}
+	for _, comp := range compartments {
+		ids = append(ids, *comp.Id)
+	}
+	policies = make([]PolicySummary, 0)
+	for _, id := range ids {
+		items, err := controller.identityCtrl.ListPolicies(controller.context, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range items {
+			policies = append(policies, PolicySummary{Policy: policy, CompartmentPath: paths[id]})
+		}
+	}
+	sort.SliceStable(policies, func(i, j int) bool {
+		if policies[i].CompartmentPath != policies[j].CompartmentPath {
+			return policies[i].CompartmentPath < policies[j].CompartmentPath
+		}
+		return strings.ToLower(*policies[i].Policy.Name) < strings.ToLower(*policies[j].Policy.Name)
+	})
+	return policies, nil
+}
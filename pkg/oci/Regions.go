@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"github.com/oracle/oci-go-sdk/v52/identity"
+)
+
+// Region with subscription status of the tenancy, Status is empty if tenancy is not subscribed.
+type RegionStatus struct {
+	Region identity.Region
+	Status identity.RegionSubscriptionStatusEnum
+	IsHome bool
+}
+
+// Lists regions the tenancy is subscribed to and name of its home region.
+func (controller *OCIController) ListSubscribedRegions() (regions []identity.Region, homeRegion string, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, "", err
+	}
+	tenancy, err := controller.identityCtrl.GetTenancy(controller.context, tenancyId)
+	if err != nil {
+		return nil, "", err
+	}
+	subscriptions, err := controller.identityCtrl.ListRegionSubscriptions(controller.context, tenancyId)
+	if err != nil {
+		return nil, "", err
+	}
+	regions = make([]identity.Region, 0)
+	for _, subscription := range subscriptions {
+		if subscription.Status != identity.RegionSubscriptionStatusReady {
+			continue
+		}
+		regions = append(regions, identity.Region{Key: subscription.RegionKey, Name: subscription.RegionName})
+		if tenancy.HomeRegionKey != nil && *tenancy.HomeRegionKey == *subscription.RegionKey {
+			homeRegion = *subscription.RegionName
+		}
+	}
+	return regions, homeRegion, nil
+}
+
+// Lists all regions with subscription status of the tenancy.
+func (controller *OCIController) ListRegionStatuses() (statuses []RegionStatus, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	regions, err := controller.identityCtrl.ListRegions(controller.context)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := controller.identityCtrl.ListRegionSubscriptions(controller.context, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	subscribed := make(map[string]identity.RegionSubscription)
+	for _, subscription := range subscriptions {
+		subscribed[*subscription.RegionKey] = subscription
+	}
+	statuses = make([]RegionStatus, 0)
+	for _, region := range regions {
+		status := RegionStatus{Region: region}
+		if subscription, ok := subscribed[*region.Key]; ok {
+			status.Status = subscription.Status
+			status.IsHome = subscription.IsHomeRegion != nil && *subscription.IsHomeRegion
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Subscribes tenancy to region, request is sent to home region of the tenancy.
+func (controller *OCIController) SubscribeRegion(regionKey string) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	_, err = controller.identityCtrl.CreateRegionSubscription(controller.context, tenancyId, regionKey)
+	return err
+}
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v52/workrequests"
+)
+
+// Resource types which can be moved between compartments.
+const (
+	ResourceInstance    = "INSTANCE"
+	ResourceVolume      = "VOLUME"
+	ResourceBootVolume  = "BOOT VOLUME"
+	ResourceVcn         = "VCN"
+	ResourceImage       = "IMAGE"
+	ResourceCompartment = "COMPARTMENT"
+)
+
+// Common description of resources of different types living in compartment.
+type ResourceSummary struct {
+	Type           string
+	Id             string
+	Name           string
+	CompartmentId  string
+	LifecycleState string
+	TimeCreated    time.Time
+}
+
+// Lists volumes, boot volumes, VCNs and custom images of the compartment.
+func (controller *OCIController) ListCompartmentResources(compartmentId string) (resources []ResourceSummary, err error) {
+	volumes, err := controller.coreCtrl.ListVolumes(controller.context, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range volumes {
+		resources = append(resources, ResourceSummary{ResourceVolume, *v.Id, *v.DisplayName, *v.CompartmentId, string(v.LifecycleState), v.TimeCreated.Time})
+	}
+
+	ads, err := controller.ListAvailabilityDomains()
+	if err != nil {
+		return nil, err
+	}
+	for _, ad := range ads {
+		bootVolumes, err := controller.coreCtrl.ListBootVolumes(controller.context, compartmentId, *ad.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range bootVolumes {
+			resources = append(resources, ResourceSummary{ResourceBootVolume, *v.Id, *v.DisplayName, *v.CompartmentId, string(v.LifecycleState), v.TimeCreated.Time})
+		}
+	}
+
+	vcns, err := controller.coreCtrl.ListVcns(controller.context, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vcns {
+		resources = append(resources, ResourceSummary{ResourceVcn, *v.Id, *v.DisplayName, *v.CompartmentId, string(v.LifecycleState), v.TimeCreated.Time})
+	}
+
+	images, err := controller.coreCtrl.ListImages(controller.context, compartmentId, "")
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range images {
+		// platform images are listed in every compartment
+		if i.CompartmentId == nil || *i.CompartmentId != compartmentId {
+			continue
+		}
+		resources = append(resources, ResourceSummary{ResourceImage, *i.Id, *i.DisplayName, *i.CompartmentId, string(i.LifecycleState), i.TimeCreated.Time})
+	}
+	return resources, nil
+}
+
+// Moves resource to target compartment and waits until move is finished.
+// Progress is reported as text through onProgress (can be nil).
+func (controller *OCIController) MoveResource(resourceType string, resourceId string, targetCompartmentId string, onProgress func(progress string)) error {
+	report := func(progress string) {
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+	var workRequestId string
+	var err error
+	switch resourceType {
+	case ResourceCompartment:
+		return controller.MoveCompartment(resourceId, targetCompartmentId, onProgress)
+	case ResourceInstance:
+		workRequestId, err = controller.coreCtrl.ChangeInstanceCompartment(controller.context, resourceId, targetCompartmentId)
+	case ResourceVcn:
+		workRequestId, err = controller.coreCtrl.ChangeVcnCompartment(controller.context, resourceId, targetCompartmentId)
+	case ResourceVolume:
+		err = controller.coreCtrl.ChangeVolumeCompartment(controller.context, resourceId, targetCompartmentId)
+	case ResourceBootVolume:
+		err = controller.coreCtrl.ChangeBootVolumeCompartment(controller.context, resourceId, targetCompartmentId)
+	case ResourceImage:
+		err = controller.coreCtrl.ChangeImageCompartment(controller.context, resourceId, targetCompartmentId)
+	default:
+		err = fmt.Errorf("resource type %s can not be moved", resourceType)
+	}
+	if err != nil {
+		return err
+	}
+
+	if workRequestId != "" {
+		report(fmt.Sprintf("work request %s accepted", workRequestId))
+		_, err = controller.workRequestCtrl.WaitForWorkRequest(controller.context, workRequestId, 5*time.Second, func(wr *workrequests.WorkRequest) {
+			report(fmt.Sprintf("work request %s: %s %.0f%%", *wr.Id, wr.Status, *wr.PercentComplete))
+		})
+		return err
+	}
+
+	// resources without work request are polled until they show up in target compartment
+	for {
+		compartmentId, state, err := controller.getResourceCompartment(resourceType, resourceId)
+		if err != nil {
+			return err
+		}
+		report(fmt.Sprintf("%s %s: %s", resourceType, resourceId, state))
+		if compartmentId == targetCompartmentId {
+			return nil
+		}
+		select {
+		case <-controller.context.Done():
+			return controller.context.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func (controller *OCIController) getResourceCompartment(resourceType string, resourceId string) (compartmentId string, state string, err error) {
+	switch resourceType {
+	case ResourceVolume:
+		v, err := controller.coreCtrl.GetVolume(controller.context, resourceId)
+		if err != nil {
+			return "", "", err
+		}
+		return *v.CompartmentId, string(v.LifecycleState), nil
+	case ResourceBootVolume:
+		v, err := controller.coreCtrl.GetBootVolume(controller.context, resourceId)
+		if err != nil {
+			return "", "", err
+		}
+		return *v.CompartmentId, string(v.LifecycleState), nil
+	case ResourceImage:
+		i, err := controller.coreCtrl.GetImage(controller.context, resourceId)
+		if err != nil {
+			return "", "", err
+		}
+		return *i.CompartmentId, string(i.LifecycleState), nil
+	default:
+		return "", "", fmt.Errorf("resource type %s is not supported", resourceType)
+	}
+}
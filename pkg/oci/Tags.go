@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/identity"
+)
+
+func (controller *OCIController) ListTagNamespaces(compartmentId string) (namespaces []identity.TagNamespaceSummary, err error) {
+	return controller.identityCtrl.ListTagNamespaces(controller.context, compartmentId, false)
+}
+
+// Lists tag definitions of the namespace including their validators.
+func (controller *OCIController) ListTagDefinitions(namespaceId string) (tags []identity.Tag, err error) {
+	summaries, err := controller.identityCtrl.ListTags(controller.context, namespaceId)
+	if err != nil {
+		return nil, err
+	}
+	tags = make([]identity.Tag, 0)
+	for _, summary := range summaries {
+		tag, err := controller.identityCtrl.GetTag(controller.context, namespaceId, *summary.Name)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, *tag)
+	}
+	return tags, nil
+}
+
+func (controller *OCIController) ListTagDefaults(compartmentId string) (defaults []identity.TagDefaultSummary, err error) {
+	return controller.identityCtrl.ListTagDefaults(controller.context, compartmentId)
+}
+
+// Creates tag default applying tag value to resources created in compartment.
+func (controller *OCIController) CreateTagDefault(compartmentId string, tagDefinitionId string, value string, required bool) (tagDefault *identity.TagDefault, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.CreateTagDefault(controller.context, tenancyId, identity.CreateTagDefaultDetails{
+		CompartmentId:   common.String(compartmentId),
+		TagDefinitionId: common.String(tagDefinitionId),
+		Value:           common.String(value),
+		IsRequired:      common.Bool(required),
+	})
+}
+
+func (controller *OCIController) DeleteTagDefault(tagDefaultId string) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	return controller.identityCtrl.DeleteTagDefault(controller.context, tenancyId, tagDefaultId)
+}
+
+func (controller *OCIController) ListCostTrackingTags() (tags []identity.Tag, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.ListCostTrackingTags(controller.context, tenancyId)
+}
+
+func (controller *OCIController) CreateTagNamespace(compartmentId string, name string, description string) (namespace *identity.TagNamespace, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.CreateTagNamespace(controller.context, tenancyId, identity.CreateTagNamespaceDetails{
+		CompartmentId: common.String(compartmentId),
+		Name:          common.String(name),
+		Description:   common.String(description),
+	})
+}
+
+// Creates tag definition, non empty enumValues create tag with enum validator.
+func (controller *OCIController) CreateTag(namespaceId string, name string, description string, costTracking bool, enumValues []string) (tag *identity.Tag, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	details := identity.CreateTagDetails{
+		Name:           common.String(name),
+		Description:    common.String(description),
+		IsCostTracking: common.Bool(costTracking),
+	}
+	if len(enumValues) > 0 {
+		details.Validator = identity.EnumTagDefinitionValidator{Values: enumValues}
+	}
+	return controller.identityCtrl.CreateTag(controller.context, tenancyId, namespaceId, details)
+}
+
+func (controller *OCIController) SetTagNamespaceRetired(namespaceId string, retired bool) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	_, err = controller.identityCtrl.UpdateTagNamespace(controller.context, tenancyId, namespaceId, identity.UpdateTagNamespaceDetails{IsRetired: common.Bool(retired)})
+	return err
+}
+
+func (controller *OCIController) SetTagRetired(namespaceId string, tagName string, retired bool) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	_, err = controller.identityCtrl.UpdateTag(controller.context, tenancyId, namespaceId, tagName, identity.UpdateTagDetails{IsRetired: common.Bool(retired)})
+	return err
+}
+
+// Returns allowed values of tag with enum validator, ok is false if tag accepts any value.
+func TagEnumValues(tag identity.Tag) (values []string, ok bool) {
+	if validator, isEnum := tag.Validator.(identity.EnumTagDefinitionValidator); isEnum {
+		return validator.Values, true
+	}
+	if validator, isEnum := tag.Validator.(*identity.EnumTagDefinitionValidator); isEnum && validator != nil {
+		return validator.Values, true
+	}
+	return nil, false
+}
+
+// Validates defined tag value against tag definition: namespace and tag have to exist,
+// can not be retired and value has to be one of allowed values of enum validator.
+func (controller *OCIController) ValidateDefinedTag(namespaceName string, tagName string, value string) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	namespaces, err := controller.identityCtrl.ListTagNamespaces(controller.context, tenancyId, true)
+	if err != nil {
+		return err
+	}
+	var namespace *identity.TagNamespaceSummary
+	for idx := range namespaces {
+		if strings.EqualFold(*namespaces[idx].Name, namespaceName) {
+			namespace = &namespaces[idx]
+			break
+		}
+	}
+	if namespace == nil {
+		return fmt.Errorf("tag namespace %s does not exist", namespaceName)
+	}
+	if namespace.IsRetired != nil && *namespace.IsRetired {
+		return fmt.Errorf("tag namespace %s is retired", namespaceName)
+	}
+	tag, err := controller.identityCtrl.GetTag(controller.context, *namespace.Id, tagName)
+	if err != nil {
+		if serviceErr, ok := common.IsServiceError(err); ok && serviceErr.GetHTTPStatusCode() == 404 {
+			return fmt.Errorf("tag %s.%s does not exist", namespaceName, tagName)
+		}
+		return err
+	}
+	if tag.IsRetired != nil && *tag.IsRetired {
+		return fmt.Errorf("tag %s.%s is retired", namespaceName, tagName)
+	}
+	if values, ok := TagEnumValues(*tag); ok {
+		for _, allowed := range values {
+			if allowed == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("value of %s.%s has to be one of: %s", namespaceName, tagName, strings.Join(values, ", "))
+	}
+	return nil
+}
+
+// Replaces free and defined tags of compartment, request is sent to home region of the tenancy.
+func (controller *OCIController) UpdateCompartmentTags(compartmentId string, freeTags map[string]string, definedTags map[string]map[string]interface{}) (compartment *identity.Compartment, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.UpdateCompartment(controller.context, tenancyId, compartmentId, identity.UpdateCompartmentDetails{
+		FreeformTags: freeTags,
+		DefinedTags:  definedTags,
+	})
+}
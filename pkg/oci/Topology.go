@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/oracle/oci-go-sdk/v52/core"
+)
+
+// Relationship of topology node to other node, e.g. route of subnet to gateway or peering of gateways.
+type TopologyLink struct {
+	Kind     string
+	TargetId string
+	Details  string
+}
+
+// Network entity of topology (VCN, subnet, gateway, DRG attachment, ...) with entities it contains.
+type TopologyNode struct {
+	Id         string
+	Type       string
+	Name       string
+	Attributes map[string]string
+	Children   []*TopologyNode
+	Links      []TopologyLink
+	contained  bool
+}
+
+// Network topology as forest of nodes, nodes which are not contained in any other node are roots.
+type NetworkTopology struct {
+	Roots []*TopologyNode
+	Nodes map[string]*TopologyNode
+}
+
+// Returns name of node with given id, id itself if node is not part of topology.
+func (topology *NetworkTopology) NodeName(id string) string {
+	if node, ok := topology.Nodes[id]; ok {
+		return node.Name
+	}
+	return id
+}
+
+// Returns topology of VCN, topology of all VCNs of the compartment if vcnId is empty.
+func (controller *OCIController) GetNetworkTopology(compartmentId string, vcnId string) (topology *NetworkTopology, err error) {
+	if vcnId != "" {
+		vcnTopology, err := controller.coreCtrl.GetVcnTopology(controller.context, compartmentId, vcnId)
+		if err != nil {
+			return nil, err
+		}
+		return buildNetworkTopology(vcnTopology.Entities, vcnTopology.Relationships), nil
+	}
+	networkingTopology, err := controller.coreCtrl.GetNetworkingTopology(controller.context, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	return buildNetworkTopology(networkingTopology.Entities, networkingTopology.Relationships), nil
+}
+
+func (controller *OCIController) ListVcns(compartmentId string) (vcns []core.Vcn, err error) {
+	return controller.coreCtrl.ListVcns(controller.context, compartmentId)
+}
+
+func buildNetworkTopology(entities []interface{}, relationships []core.TopologyEntityRelationship) *NetworkTopology {
+	topology := NetworkTopology{Nodes: make(map[string]*TopologyNode)}
+	var order []string
+	for _, entity := range entities {
+		node := newTopologyNode(entity)
+		if node == nil {
+			continue
+		}
+		topology.Nodes[node.Id] = node
+		order = append(order, node.Id)
+	}
+	for _, relationship := range relationships {
+		from, ok := topology.Nodes[StringOrEmpty(relationship.GetId1())]
+		if !ok {
+			continue
+		}
+		targetId := StringOrEmpty(relationship.GetId2())
+		switch r := relationship.(type) {
+		case core.TopologyContainsEntityRelationship:
+			if to, ok := topology.Nodes[targetId]; ok && to != from {
+				from.Children = append(from.Children, to)
+				to.contained = true
+			}
+		case core.TopologyAssociatedWithEntityRelationship:
+			details := ""
+			if r.AssociatedWithDetails != nil && len(r.AssociatedWithDetails.Via) > 0 {
+				var via []string
+				for _, id := range r.AssociatedWithDetails.Via {
+					via = append(via, topology.NodeName(id))
+				}
+				details = "via " + strings.Join(via, ", ")
+			}
+			from.Links = append(from.Links, TopologyLink{string(core.TopologyEntityRelationshipTypeAssociatedWith), targetId, details})
+		case core.TopologyRoutesToEntityRelationship:
+			details := ""
+			if r.RouteRuleDetails != nil {
+				details = fmt.Sprintf("%s %s", StringOrEmpty(r.RouteRuleDetails.DestinationType), StringOrEmpty(r.RouteRuleDetails.Destination))
+				if r.RouteRuleDetails.RouteType != "" {
+					details += " " + string(r.RouteRuleDetails.RouteType)
+				}
+			}
+			from.Links = append(from.Links, TopologyLink{string(core.TopologyEntityRelationshipTypeRoutesTo), targetId, details})
+		}
+	}
+	for _, id := range order {
+		node := topology.Nodes[id]
+		sort.SliceStable(node.Children, func(i, j int) bool {
+			if node.Children[i].Type != node.Children[j].Type {
+				return node.Children[i].Type < node.Children[j].Type
+			}
+			return node.Children[i].Name < node.Children[j].Name
+		})
+		if !node.contained {
+			topology.Roots = append(topology.Roots, node)
+		}
+	}
+	// VCNs first, other entities (DRGs, ...) after them
+	sort.SliceStable(topology.Roots, func(i, j int) bool {
+		return strings.EqualFold(topology.Roots[i].Type, "vcn") && !strings.EqualFold(topology.Roots[j].Type, "vcn")
+	})
+	return &topology
+}
+
+// Creates node from topology entity, entities are returned as generic JSON objects.
+func newTopologyNode(entity interface{}) *TopologyNode {
+	values, ok := entity.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	node := TopologyNode{Attributes: make(map[string]string)}
+	for key, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			node.Attributes[key] = v
+		default:
+			node.Attributes[key] = fmt.Sprintf("%v", v)
+		}
+	}
+	node.Id = node.Attributes["id"]
+	if node.Id == "" {
+		return nil
+	}
+	node.Type = node.Attributes["type"]
+	if node.Type == "" {
+		node.Type = topologyTypeFromId(node.Id)
+	}
+	node.Name = node.Attributes["displayName"]
+	if node.Name == "" {
+		node.Name = node.Attributes["name"]
+	}
+	if node.Name == "" {
+		node.Name = node.Id
+	}
+	return &node
+}
+
+// Resource type from OCID, e.g. ocid1.subnet.oc1... is subnet.
+func topologyTypeFromId(id string) string {
+	parts := strings.Split(id, ".")
+	if len(parts) > 1 {
+		return parts[1]
+	}
+	return "unknown"
+}
@@ -0,0 +1,227 @@
+package controller
+
+import (
+	"crypto/md5"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/oracle/oci-go-sdk/v52/identity"
+)
+
+// User with names of its groups and its credentials.
+type UserOverview struct {
+	User       identity.User
+	Groups     []string
+	ApiKeys    []identity.ApiKey
+	AuthTokens []identity.AuthToken
+}
+
+func (controller *OCIController) getTenancyId() (string, error) {
+	confPrv := *(controller.configProvider)
+	return confPrv.TenancyOCID()
+}
+
+// Number of concurrent requests used when details of many users or groups are listed.
+const listWorkers = 8
+
+// Calls fn for indexes 0..count-1 using at most workers goroutines, the first error is returned.
+func forEachConcurrently(count int, workers int, fn func(idx int) error) error {
+	indexes := make(chan int)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for worker := 0; worker < workers; worker++ {
+		go func(worker int) {
+			defer wg.Done()
+			for idx := range indexes {
+				if errs[worker] == nil {
+					errs[worker] = fn(idx)
+				}
+			}
+		}(worker)
+	}
+	for idx := 0; idx < count; idx++ {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Lists all users of the tenancy with their group memberships, API keys and auth tokens.
+// Memberships are listed per group (API needs user or group filter) and mapped to users,
+// credentials of users are listed concurrently.
+func (controller *OCIController) ListUsersOverview() (overview []UserOverview, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	users, err := controller.identityCtrl.ListUsers(controller.context, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := controller.identityCtrl.ListGroups(controller.context, tenancyId)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroups := make(map[string][]string)
+	var userGroupsMu sync.Mutex
+	err = forEachConcurrently(len(groups), listWorkers, func(idx int) error {
+		group := groups[idx]
+		memberships, err := controller.identityCtrl.ListUserGroupMemberships(controller.context, tenancyId, "", *group.Id)
+		if err != nil {
+			return err
+		}
+		userGroupsMu.Lock()
+		defer userGroupsMu.Unlock()
+		for _, membership := range memberships {
+			userGroups[*membership.UserId] = append(userGroups[*membership.UserId], *group.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	overview = make([]UserOverview, len(users))
+	err = forEachConcurrently(len(users), listWorkers, func(idx int) error {
+		user := users[idx]
+		keys, err := controller.identityCtrl.ListApiKeys(controller.context, *user.Id)
+		if err != nil {
+			return err
+		}
+		tokens, err := controller.identityCtrl.ListAuthTokens(controller.context, *user.Id)
+		if err != nil {
+			return err
+		}
+		overview[idx] = UserOverview{
+			User:       user,
+			ApiKeys:    keys,
+			AuthTokens: tokens,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for idx := range overview {
+		names := userGroups[*overview[idx].User.Id]
+		if names == nil {
+			names = make([]string, 0)
+		}
+		sort.Strings(names)
+		overview[idx].Groups = names
+	}
+	return overview, nil
+}
+
+// API key of the current profile user, IsActive is set for the key matching private key of the active profile,
+// IsConfigured for the key matching fingerprint written in the profile.
+type MyApiKey struct {
+	ApiKey       identity.ApiKey
+	IsActive     bool
+	IsConfigured bool
+}
+
+// Returns true if fingerprint in the profile does not belong to its private key.
+func (key *MyApiKey) IsMismatch() bool {
+	return key.IsActive != key.IsConfigured
+}
+
+// Returns MD5 fingerprint of public part of the key, in the format used by OCI API keys.
+func publicKeyFingerprint(key *rsa.PrivateKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	sum := md5.Sum(der)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, ":"), nil
+}
+
+// Returns OCID of the user of active profile, fingerprint derived from its private key
+// and fingerprint written in the profile.
+func (controller *OCIController) getProfileUser() (userId string, fingerprint string, configuredFingerprint string, err error) {
+	confPrv := *(controller.configProvider)
+	userId, err = confPrv.UserOCID()
+	if err != nil {
+		return "", "", "", err
+	}
+	key, err := confPrv.PrivateRSAKey()
+	if err != nil {
+		return "", "", "", err
+	}
+	fingerprint, err = publicKeyFingerprint(key)
+	if err != nil {
+		return "", "", "", err
+	}
+	configuredFingerprint, err = confPrv.KeyFingerprint()
+	if err != nil {
+		return "", "", "", err
+	}
+	return userId, fingerprint, configuredFingerprint, nil
+}
+
+// Lists API keys of the user of active profile, marking the key of profile private key
+// and the key of profile fingerprint.
+func (controller *OCIController) ListMyApiKeys() (keys []MyApiKey, err error) {
+	userId, fingerprint, configuredFingerprint, err := controller.getProfileUser()
+	if err != nil {
+		return nil, err
+	}
+	apiKeys, err := controller.identityCtrl.ListApiKeys(controller.context, userId)
+	if err != nil {
+		return nil, err
+	}
+	keys = make([]MyApiKey, 0)
+	for _, key := range apiKeys {
+		keys = append(keys, MyApiKey{
+			ApiKey:       key,
+			IsActive:     key.Fingerprint != nil && *key.Fingerprint == fingerprint,
+			IsConfigured: key.Fingerprint != nil && *key.Fingerprint == configuredFingerprint,
+		})
+	}
+	return keys, nil
+}
+
+// Uploads public key in PEM format as new API key of the user of active profile.
+func (controller *OCIController) UploadMyApiKey(publicKey string) (key *identity.ApiKey, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	userId, _, _, err := controller.getProfileUser()
+	if err != nil {
+		return nil, err
+	}
+	return controller.identityCtrl.UploadApiKey(controller.context, tenancyId, userId, publicKey)
+}
+
+// Deletes API key of the user of active profile, the key used by active profile can not be deleted.
+func (controller *OCIController) DeleteMyApiKey(fingerprint string) error {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return err
+	}
+	userId, activeFingerprint, _, err := controller.getProfileUser()
+	if err != nil {
+		return err
+	}
+	if fingerprint == activeFingerprint {
+		return fmt.Errorf("API key %s is used by active profile and can not be deleted", fingerprint)
+	}
+	return controller.identityCtrl.DeleteApiKey(controller.context, tenancyId, userId, fingerprint)
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v52/common"
+	"github.com/oracle/oci-go-sdk/v52/workrequests"
+)
+
+type workRequestController struct {
+	client    *workrequests.WorkRequestClient
+	initiated bool
+}
+
+func newWorkRequestController() *workRequestController {
+	return &workRequestController{
+		client:    nil,
+		initiated: false,
+	}
+}
+
+func (controller *workRequestController) init(configProvider *common.ConfigurationProvider) error {
+	if c, err := workrequests.NewWorkRequestClientWithConfigurationProvider(*configProvider); err == nil {
+		controller.client = &c
+		controller.initiated = true
+		return nil
+	} else {
+		controller.initiated = false
+		return err
+	}
+}
+
+func (controller *workRequestController) GetWorkRequest(ctx context.Context, workRequestId string) (workRequest *workrequests.WorkRequest, err error) {
+	if !controller.initiated {
+		return nil, errors.New("work request Controller not initiated")
+	}
+	req := workrequests.GetWorkRequestRequest{WorkRequestId: common.String(workRequestId)}
+	response, err := controller.client.GetWorkRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &response.WorkRequest, nil
+}
+
+// Polls work request every interval until it is finished.
+// Returns error if work request failed or was canceled.
+func (controller *workRequestController) WaitForWorkRequest(ctx context.Context,
+	workRequestId string,
+	interval time.Duration,
+	onPoll func(workRequest *workrequests.WorkRequest)) (workRequest *workrequests.WorkRequest, err error) {
+	for {
+		workRequest, err = controller.GetWorkRequest(ctx, workRequestId)
+		if err != nil {
+			return nil, err
+		}
+		if onPoll != nil {
+			onPoll(workRequest)
+		}
+		switch workRequest.Status {
+		case workrequests.WorkRequestStatusSucceeded:
+			return workRequest, nil
+		case workrequests.WorkRequestStatusFailed, workrequests.WorkRequestStatusCanceled:
+			return workRequest, fmt.Errorf("work request %s %s", workRequestId, workRequest.Status)
+		}
+		select {
+		case <-ctx.Done():
+			return workRequest, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package controller
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v52/common"
+)
+
+// Kinds of asynchronous work requests of identity service.
+const (
+	WorkRequestCompartment = "COMPARTMENT"
+	WorkRequestIam         = "IAM"
+	WorkRequestTagging     = "TAGGING"
+)
+
+// Common description of compartment, IAM and tagging work requests.
+type IdentityWorkRequest struct {
+	Kind            string
+	Id              string
+	OperationType   string
+	Status          string
+	PercentComplete float32
+	Resources       []string
+	TimeAccepted    time.Time
+	TimeFinished    time.Time
+}
+
+// Returns true if work request will not change its status anymore.
+func (workRequest *IdentityWorkRequest) IsFinished() bool {
+	switch workRequest.Status {
+	case "SUCCEEDED", "FAILED", "CANCELED", "PARTIALLY_SUCCEEDED":
+		return true
+	}
+	return false
+}
+
+// Error or log message of work request, Code is empty for log messages.
+type WorkRequestMessage struct {
+	Timestamp time.Time
+	Code      string
+	Message   string
+}
+
+func sdkTimeOrZero(value *common.SDKTime) time.Time {
+	if value == nil {
+		return time.Time{}
+	}
+	return value.Time
+}
+
+func float32OrZero(value *float32) float32 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+// Lists compartment, IAM and tagging work requests of the compartment, newest first.
+func (controller *OCIController) ListIdentityWorkRequests(compartmentId string) (workRequests []IdentityWorkRequest, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	compartmentRequests, err := controller.identityCtrl.ListWorkRequests(controller.context, tenancyId, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range compartmentRequests {
+		workRequest := IdentityWorkRequest{
+			Kind:            WorkRequestCompartment,
+			Id:              *w.Id,
+			OperationType:   string(w.OperationType),
+			Status:          string(w.Status),
+			PercentComplete: float32OrZero(w.PercentComplete),
+			TimeAccepted:    sdkTimeOrZero(w.TimeAccepted),
+			TimeFinished:    sdkTimeOrZero(w.TimeFinished),
+		}
+		for _, r := range w.Resources {
+			workRequest.Resources = append(workRequest.Resources, fmt.Sprintf("%s %s", StringOrEmpty(r.EntityType), StringOrEmpty(r.Identifier)))
+		}
+		workRequests = append(workRequests, workRequest)
+	}
+
+	iamRequests, err := controller.identityCtrl.ListIamWorkRequests(controller.context, tenancyId, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range iamRequests {
+		workRequest := IdentityWorkRequest{
+			Kind:            WorkRequestIam,
+			Id:              *w.Id,
+			OperationType:   string(w.OperationType),
+			Status:          string(w.Status),
+			PercentComplete: float32OrZero(w.PercentComplete),
+			TimeAccepted:    sdkTimeOrZero(w.TimeAccepted),
+			TimeFinished:    sdkTimeOrZero(w.TimeFinished),
+		}
+		for _, r := range w.Resources {
+			workRequest.Resources = append(workRequest.Resources, fmt.Sprintf("%s %s", StringOrEmpty(r.EntityType), StringOrEmpty(r.Identifier)))
+		}
+		workRequests = append(workRequests, workRequest)
+	}
+
+	taggingRequests, err := controller.identityCtrl.ListTaggingWorkRequests(controller.context, tenancyId, compartmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range taggingRequests {
+		workRequest := IdentityWorkRequest{
+			Kind:            WorkRequestTagging,
+			Id:              *w.Id,
+			OperationType:   string(w.OperationType),
+			Status:          string(w.Status),
+			PercentComplete: float32OrZero(w.PercentComplete),
+			TimeAccepted:    sdkTimeOrZero(w.TimeAccepted),
+			TimeFinished:    sdkTimeOrZero(w.TimeFinished),
+		}
+		for _, r := range w.Resources {
+			workRequest.Resources = append(workRequest.Resources, fmt.Sprintf("%s %s", StringOrEmpty(r.EntityType), StringOrEmpty(r.Identifier)))
+		}
+		workRequests = append(workRequests, workRequest)
+	}
+
+	sort.SliceStable(workRequests, func(i, j int) bool {
+		return workRequests[i].TimeAccepted.After(workRequests[j].TimeAccepted)
+	})
+	return workRequests, nil
+}
+
+// Returns current state of work request of given kind.
+func (controller *OCIController) GetIdentityWorkRequest(kind string, workRequestId string) (*IdentityWorkRequest, error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, err
+	}
+	workRequest := IdentityWorkRequest{Kind: kind, Id: workRequestId}
+	switch kind {
+	case WorkRequestCompartment:
+		w, err := controller.identityCtrl.GetWorkRequest(controller.context, tenancyId, workRequestId)
+		if err != nil {
+			return nil, err
+		}
+		workRequest.OperationType = string(w.OperationType)
+		workRequest.Status = string(w.Status)
+		workRequest.PercentComplete = float32OrZero(w.PercentComplete)
+		workRequest.TimeAccepted = sdkTimeOrZero(w.TimeAccepted)
+		workRequest.TimeFinished = sdkTimeOrZero(w.TimeFinished)
+		for _, r := range w.Resources {
+			workRequest.Resources = append(workRequest.Resources, fmt.Sprintf("%s %s", StringOrEmpty(r.EntityType), StringOrEmpty(r.Identifier)))
+		}
+	case WorkRequestIam:
+		w, err := controller.identityCtrl.GetIamWorkRequest(controller.context, tenancyId, workRequestId)
+		if err != nil {
+			return nil, err
+		}
+		workRequest.OperationType = string(w.OperationType)
+		workRequest.Status = string(w.Status)
+		workRequest.PercentComplete = float32OrZero(w.PercentComplete)
+		workRequest.TimeAccepted = sdkTimeOrZero(w.TimeAccepted)
+		workRequest.TimeFinished = sdkTimeOrZero(w.TimeFinished)
+		for _, r := range w.Resources {
+			workRequest.Resources = append(workRequest.Resources, fmt.Sprintf("%s %s", StringOrEmpty(r.EntityType), StringOrEmpty(r.Identifier)))
+		}
+	case WorkRequestTagging:
+		w, err := controller.identityCtrl.GetTaggingWorkRequest(controller.context, tenancyId, workRequestId)
+		if err != nil {
+			return nil, err
+		}
+		workRequest.OperationType = string(w.OperationType)
+		workRequest.Status = string(w.Status)
+		workRequest.PercentComplete = float32OrZero(w.PercentComplete)
+		workRequest.TimeAccepted = sdkTimeOrZero(w.TimeAccepted)
+		workRequest.TimeFinished = sdkTimeOrZero(w.TimeFinished)
+		for _, r := range w.Resources {
+			workRequest.Resources = append(workRequest.Resources, fmt.Sprintf("%s %s", StringOrEmpty(r.EntityType), StringOrEmpty(r.Identifier)))
+		}
+	default:
+		return nil, fmt.Errorf("work request kind %s is not supported", kind)
+	}
+	return &workRequest, nil
+}
+
+// Returns errors and log messages of work request of given kind.
+func (controller *OCIController) ListIdentityWorkRequestMessages(kind string, workRequestId string) (workRequestErrors []WorkRequestMessage, logs []WorkRequestMessage, err error) {
+	tenancyId, err := controller.getTenancyId()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch kind {
+	case WorkRequestCompartment:
+		workRequest, err := controller.identityCtrl.GetWorkRequest(controller.context, tenancyId, workRequestId)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, e := range workRequest.Errors {
+			workRequestErrors = append(workRequestErrors, WorkRequestMessage{sdkTimeOrZero(e.Timestamp), StringOrEmpty(e.Code), StringOrEmpty(e.Message)})
+		}
+		for _, l := range workRequest.Logs {
+			logs = append(logs, WorkRequestMessage{sdkTimeOrZero(l.Timestamp), "", StringOrEmpty(l.Message)})
+		}
+	case WorkRequestIam:
+		iamErrors, err := controller.identityCtrl.ListIamWorkRequestErrors(controller.context, tenancyId, workRequestId)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, e := range iamErrors {
+			workRequestErrors = append(workRequestErrors, WorkRequestMessage{sdkTimeOrZero(e.Timestamp), StringOrEmpty(e.Code), StringOrEmpty(e.Message)})
+		}
+		iamLogs, err := controller.identityCtrl.ListIamWorkRequestLogs(controller.context, tenancyId, workRequestId)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, l := range iamLogs {
+			logs = append(logs, WorkRequestMessage{sdkTimeOrZero(l.Timestamp), "", StringOrEmpty(l.Message)})
+		}
+	case WorkRequestTagging:
+		taggingErrors, err := controller.identityCtrl.ListTaggingWorkRequestErrors(controller.context, tenancyId, workRequestId)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, e := range taggingErrors {
+			workRequestErrors = append(workRequestErrors, WorkRequestMessage{sdkTimeOrZero(e.Timestamp), StringOrEmpty(e.Code), StringOrEmpty(e.Message)})
+		}
+		taggingLogs, err := controller.identityCtrl.ListTaggingWorkRequestLogs(controller.context, tenancyId, workRequestId)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, l := range taggingLogs {
+			logs = append(logs, WorkRequestMessage{sdkTimeOrZero(l.Timestamp), "", StringOrEmpty(l.Message)})
+		}
+	default:
+		return nil, nil, fmt.Errorf("work request kind %s is not supported", kind)
+	}
+	return workRequestErrors, logs, nil
+}